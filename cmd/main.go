@@ -3,95 +3,351 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/gorilla/mux"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
 
 	"github.com/signalable/quser/internal/client"
 	"github.com/signalable/quser/internal/config"
 	"github.com/signalable/quser/internal/delivery/http/handler"
 	"github.com/signalable/quser/internal/delivery/http/middleware"
 	"github.com/signalable/quser/internal/delivery/http/routes"
+	"github.com/signalable/quser/internal/emailnorm"
+	"github.com/signalable/quser/internal/health"
+	"github.com/signalable/quser/internal/lifecycle"
+	"github.com/signalable/quser/internal/logger"
+	"github.com/signalable/quser/internal/mailer"
+	"github.com/signalable/quser/internal/metrics"
+	"github.com/signalable/quser/internal/ratelimit"
 	"github.com/signalable/quser/internal/repository/mongodb"
+	"github.com/signalable/quser/internal/security"
+	"github.com/signalable/quser/internal/tokenverify"
+	"github.com/signalable/quser/internal/tracing"
 	"github.com/signalable/quser/internal/usecase"
+	"github.com/signalable/quser/internal/validation"
+	"github.com/signalable/quser/internal/worker"
 )
 
 func main() {
+	if err := run(); err != nil {
+		slog.Error("User Service 비정상 종료", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run 서비스 초기화, 실행 및 종료 신호 수신 시 순서대로 정리
+func run() error {
 	// 설정 로드
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf("설정을 로드할 수 없습니다: %v", err)
+		return fmt.Errorf("설정을 로드할 수 없습니다: %w", err)
 	}
 
+	// 로거 초기화
+	appLogger := logger.New(cfg.LogLevel, cfg.LogFormat)
+	slog.SetDefault(appLogger)
+
+	// 종료 신호 (Kubernetes 롤아웃 시 SIGTERM)
+	signalCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 종료 기한: 종료 신호를 받은 시점부터 ShutdownTimeout 안에 드레인 대기, HTTP 서버 종료, 종료 작업을 모두 마침
+	// (Kubernetes terminationGracePeriodSeconds보다 짧게 설정해야 MongoDB 연결 종료 전에 강제 종료되지 않음)
+	var shutdownDeadline time.Time
+
+	// 생명주기 관리자: 종료 작업은 등록의 역순으로 실행
+	lc := lifecycle.NewManager(appLogger)
+	defer func() {
+		// 종료 신호 없이 반환하는 경우 (기동 실패, 서버 오류)
+		if shutdownDeadline.IsZero() {
+			shutdownDeadline = time.Now().Add(cfg.Server.ShutdownTimeout)
+		}
+		shutdownCtx, cancel := context.WithDeadline(context.Background(), shutdownDeadline)
+		defer cancel()
+		if err := lc.Shutdown(shutdownCtx); err != nil {
+			appLogger.Error("종료 작업 실패", "error", err)
+		}
+	}()
+
+	// 트레이싱 초기화 (가장 먼저 등록하여 마지막에 남은 스팬을 내보냄)
+	shutdownTracing, err := tracing.Setup(signalCtx, cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("트레이싱 초기화 실패: %w", err)
+	}
+	lc.OnShutdown("tracing", shutdownTracing)
+
 	// MongoDB 연결
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(signalCtx, 10*time.Second)
 	defer cancel()
 
-	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoDB.URI))
+	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoDB.URI).SetMonitor(otelmongo.NewMonitor()))
 	if err != nil {
-		log.Fatalf("MongoDB 연결 실패: %v", err)
+		return fmt.Errorf("MongoDB 연결 실패: %w", err)
 	}
-	defer mongoClient.Disconnect(ctx)
+	lc.OnShutdown("mongodb", mongoClient.Disconnect)
 
 	// MongoDB 연결 테스트
 	if err := mongoClient.Ping(ctx, nil); err != nil {
-		log.Fatalf("MongoDB 연결 테스트 실패: %v", err)
+		return fmt.Errorf("MongoDB 연결 테스트 실패: %w", err)
 	}
 
+	// 이메일 정규화 초기화
+	emailNormalizer := emailnorm.NewNormalizer(cfg.Email.ProviderRules)
+
+	// 기존 사용자 정규화 이메일 채우기 (unique 인덱스 생성 전에 실행)
+	// 전체 컬렉션을 훑으므로 연결 제한 시간 대신 종료 신호로만 취소
+	backfilled, err := mongodb.BackfillNormalizedEmails(signalCtx, mongoClient.Database(cfg.MongoDB.Database), emailNormalizer.Canonical)
+	if err != nil {
+		return fmt.Errorf("정규화 이메일 마이그레이션 실패: %w", err)
+	}
+	if backfilled > 0 {
+		appLogger.Info("정규화 이메일 마이그레이션 완료", "count", backfilled)
+	}
+
+	// 정규화 이메일 중복 확인 (중복이 있으면 unique 인덱스를 만들 수 없으므로 정리할 계정을 알리고 중단)
+	collisions, err := mongodb.FindNormalizedEmailCollisions(signalCtx, mongoClient.Database(cfg.MongoDB.Database))
+	if err != nil {
+		return fmt.Errorf("정규화 이메일 중복 확인 실패: %w", err)
+	}
+	for _, collision := range collisions {
+		appLogger.Error("정규화 이메일 중복", "email_normalized", collision.EmailNormalized, "user_ids", collision.UserIDs)
+	}
+	if len(collisions) > 0 {
+		return fmt.Errorf("정규화 이메일이 겹치는 계정 %d건을 정리해야 합니다", len(collisions))
+	}
+
+	// 인덱스 마이그레이션
+	if err := mongodb.EnsureIndexes(signalCtx, mongoClient.Database(cfg.MongoDB.Database)); err != nil {
+		return fmt.Errorf("MongoDB 인덱스 마이그레이션 실패: %w", err)
+	}
+
+	// 지표 초기화
+	appMetrics := metrics.New()
+
 	// Auth 클라이언트 초기화
 	authClient := client.NewAuthClient(
 		cfg.AuthService.URL,
+		cfg.AuthService.HealthPath,
 		cfg.AuthService.Timeout,
+		cfg.AuthService.TokenCacheSize,
+		cfg.AuthService.TokenCacheTTL,
+		client.RetryPolicy{
+			MaxRetries: cfg.AuthService.MaxRetries,
+			BaseDelay:  cfg.AuthService.RetryBaseDelay,
+			MaxDelay:   cfg.AuthService.RetryMaxDelay,
+		},
+		client.BreakerPolicy{
+			Threshold:    cfg.AuthService.BreakerThreshold,
+			OpenDuration: cfg.AuthService.BreakerOpenDuration,
+		},
+		appLogger,
 	)
+	authClient.SetObserver(appMetrics.ObserveAuthCall)
+	appMetrics.RegisterTokenCache(authClient.CacheStats)
+
+	// 토큰 검증 방식 선택 (local이면 JWKS와 폐기 목록을 받아 Auth 서비스 호출 없이 검증)
+	// local 모드는 Auth 서비스 장애 중에도 요청을 처리하기 위한 것이므로 준비 상태는 Auth 서비스 대신 검증기 상태로 판단
+	var tokenVerifier middleware.TokenVerifier = authClient
+	authCheck := health.NewCheck("auth-service", authClient.Ping)
+	if cfg.TokenVerify.Mode == "local" {
+		localVerifier := tokenverify.NewLocalVerifier(cfg.AuthService.URL, cfg.AuthService.Timeout, cfg.TokenVerify, appLogger)
+		localVerifier.Start()
+		lc.OnShutdown("token-verifier", localVerifier.Stop)
+		tokenVerifier = localVerifier
+		authCheck = health.NewCheck("token-verifier", localVerifier.Ready)
+	}
 
 	// 레포지토리 초기화
-	userRepo := mongodb.NewUserRepository(mongoClient.Database(cfg.MongoDB.Database))
+	db := mongoClient.Database(cfg.MongoDB.Database)
+	userRepo := metrics.NewInstrumentedUserRepository(mongodb.NewUserRepository(db, appLogger), appMetrics)
+	verificationTokenRepo := mongodb.NewEmailVerificationTokenRepository(db)
+	passwordResetTokenRepo := mongodb.NewPasswordResetTokenRepository(db)
+	loginEventRepo := mongodb.NewLoginEventRepository(db)
+	sessionRepo := mongodb.NewSessionRepository(db)
+
+	// 메일러 초기화
+	var mailSender mailer.Mailer
+	switch cfg.Mail.Driver {
+	case "smtp":
+		mailSender = mailer.NewSMTPMailer(
+			cfg.Mail.SMTPHost,
+			cfg.Mail.SMTPPort,
+			cfg.Mail.SMTPUsername,
+			cfg.Mail.SMTPPassword,
+			cfg.Mail.From,
+		)
+	default:
+		mailSender = mailer.NewLogMailer(cfg.Mail.LogFile, appLogger)
+	}
+
+	// 비밀번호 해시 초기화
+	passwordHasher := security.NewArgon2Hasher(security.Argon2Params{
+		Memory:      cfg.Password.Argon2Memory,
+		Iterations:  cfg.Password.Argon2Iterations,
+		Parallelism: cfg.Password.Argon2Parallelism,
+	})
 
 	// 유스케이스 초기화
-	userUseCase := usecase.NewUserUseCase(userRepo, authClient)
+	userUseCase, err := usecase.NewUserUseCase(
+		userRepo,
+		verificationTokenRepo,
+		passwordResetTokenRepo,
+		loginEventRepo,
+		sessionRepo,
+		authClient,
+		passwordHasher,
+		emailNormalizer,
+		mailSender,
+		cfg.Verification,
+		cfg.PasswordReset,
+		cfg.Deletion,
+		cfg.Lockout,
+		cfg.LoginHistory,
+		cfg.Session,
+		appLogger,
+	)
+	if err != nil {
+		return fmt.Errorf("User 유스케이스 초기화 실패: %w", err)
+	}
+	userUseCase = metrics.NewInstrumentedUserUseCase(userUseCase, appMetrics)
+	userUseCase = tracing.NewTracedUserUseCase(userUseCase)
+
+	// 백그라운드 작업 시작
+	accountPurger := worker.NewAccountPurger(userUseCase, cfg.Deletion.PurgeInterval, appLogger)
+	accountPurger.Start()
+	lc.OnShutdown("account-purger", accountPurger.Stop)
 
 	// 핸들러 및 미들웨어 초기화
-	userHandler := handler.NewUserHandler(userUseCase)
-	authMiddleware := middleware.NewAuthMiddleware(authClient)
+	userHandler := handler.NewUserHandler(userUseCase, validation.NewValidator(), appLogger)
+	authMiddleware := middleware.NewAuthMiddleware(tokenVerifier, userUseCase, appLogger)
+
+	// 요청 제한 초기화
+	rateLimitStore := ratelimit.NewMemoryStore()
+	if cfg.RateLimit.Store == "mongodb" {
+		rateLimitStore = mongodb.NewRateLimitStore(db)
+	}
+	rateLimiter := middleware.NewRateLimiter(
+		ratelimit.NewLimiter(rateLimitStore),
+		map[string]middleware.RateLimitRules{
+			middleware.RateLimitScopeLogin: {
+				PerIP:      ratelimit.Limit{Capacity: cfg.RateLimit.LoginPerIP, Period: time.Minute},
+				PerEmail:   ratelimit.Limit{Capacity: cfg.RateLimit.LoginPerEmail, Period: time.Minute},
+				PerIPEmail: ratelimit.Limit{Capacity: cfg.RateLimit.LoginPerIPEmail, Period: time.Minute},
+			},
+			middleware.RateLimitScopeRegister: {
+				PerIP:    ratelimit.Limit{Capacity: cfg.RateLimit.RegisterPerIP, Period: time.Hour},
+				PerEmail: ratelimit.Limit{Capacity: cfg.RateLimit.RegisterPerEmail, Period: time.Hour},
+			},
+			middleware.RateLimitScopeVerifyEmail: {
+				PerIP: ratelimit.Limit{Capacity: cfg.RateLimit.VerifyPerIP, Period: time.Minute},
+			},
+			middleware.RateLimitScopeResendVerify: {
+				PerIP:    ratelimit.Limit{Capacity: cfg.RateLimit.ResendPerIP, Period: time.Hour},
+				PerEmail: ratelimit.Limit{Capacity: cfg.RateLimit.ResendPerEmail, Period: time.Hour},
+			},
+			middleware.RateLimitScopeForgotPassword: {
+				PerIP:    ratelimit.Limit{Capacity: cfg.RateLimit.ForgotPerIP, Period: time.Hour},
+				PerEmail: ratelimit.Limit{Capacity: cfg.RateLimit.ForgotPerEmail, Period: time.Hour},
+			},
+			middleware.RateLimitScopeResetPassword: {
+				PerIP: ratelimit.Limit{Capacity: cfg.RateLimit.ResetPerIP, Period: time.Minute},
+			},
+		},
+		emailNormalizer.Canonical,
+		appLogger,
+	)
+
+	// 상태 확인 초기화
+	healthService := health.NewService(
+		cfg.Health.CacheTTL,
+		cfg.Health.CheckTimeout,
+		appLogger,
+		health.NewCheck("mongodb", func(ctx context.Context) error {
+			return mongoClient.Ping(ctx, readpref.Primary())
+		}),
+		authCheck,
+	)
+	healthHandler := handler.NewHealthHandler(healthService, lc)
 
 	// 라우터 설정
 	router := mux.NewRouter()
-	routes.SetupUserRoutes(router, userHandler, authMiddleware)
-
-	// CORS 미들웨어 설정
-	router.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-			if r.Method == "OPTIONS" {
-				w.WriteHeader(http.StatusOK)
-				return
-			}
-
-			next.ServeHTTP(w, r)
-		})
-	})
+	routes.SetupHealthRoutes(router, healthHandler)
+	routes.SetupMetricsRoutes(router, appMetrics)
+	routes.SetupUserRoutes(router, userHandler, authMiddleware, rateLimiter)
+
+	// CORS 정책 설정 (운영용 엔드포인트는 교차 출처 요청 차단)
+	cors, err := middleware.NewCORS(router, middleware.NewCORSPolicy(cfg.CORS))
+	if err != nil {
+		return fmt.Errorf("CORS 설정 오류: %w", err)
+	}
+	for _, path := range []string{"/healthz", "/readyz", "/metrics"} {
+		if err := cors.Override(path, middleware.CORSPolicy{}); err != nil {
+			return fmt.Errorf("CORS 설정 오류: %w", err)
+		}
+	}
+
+	// 라우트 매칭 전에 실행되는 미들웨어 (요청 ID → 클라이언트 IP → 라우트 템플릿 → 트레이싱 → 접근 로그 → 지표 → CORS 순)
+	var rootHandler http.Handler = cors.Handler(router)
+	rootHandler = middleware.Metrics(appMetrics)(rootHandler)
+	rootHandler = middleware.AccessLog(appLogger)(rootHandler)
+	rootHandler = middleware.Tracing(cfg.Tracing.ServiceName)(rootHandler)
+	rootHandler = middleware.Route(router)(rootHandler)
+	rootHandler = middleware.ClientIP(cfg.Server.TrustedProxyHops)(rootHandler)
+	rootHandler = middleware.RequestID(rootHandler)
 
 	// 서버 시작
 	serverAddr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
-	log.Printf("User Service 시작: %s", serverAddr)
 
 	server := &http.Server{
 		Addr:         serverAddr,
-		Handler:      router,
+		Handler:      rootHandler,
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
 
-	if err := server.ListenAndServe(); err != nil {
-		log.Fatalf("서버 실행 실패: %v", err)
+	serverErr := make(chan error, 1)
+	go func() {
+		appLogger.Info("User Service 시작", "addr", serverAddr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+	lc.SetReady(true)
+
+	// 종료 신호 또는 서버 오류 대기
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("서버 실행 실패: %w", err)
+	case <-signalCtx.Done():
+		shutdownDeadline = time.Now().Add(cfg.Server.ShutdownTimeout)
+		appLogger.Info("종료 신호 수신, 트래픽 드레인 시작")
+	}
+
+	// 새 트래픽이 들어오지 않도록 not-ready로 전환 후 로드밸런서 반영 대기
+	lc.SetReady(false)
+	time.Sleep(cfg.Server.DrainDelay)
+
+	// 진행 중인 요청 완료 대기 (이후 defer에서 같은 기한의 남은 시간으로 워커, MongoDB 순으로 종료)
+	shutdownCtx, cancelShutdown := context.WithDeadline(context.Background(), shutdownDeadline)
+	defer cancelShutdown()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("서버 종료 실패: %w", err)
 	}
+
+	appLogger.Info("User Service 종료")
+	return nil
 }
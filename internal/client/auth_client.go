@@ -4,17 +4,31 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
-	"github.com/signalable/quser/internal/domain"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/signalable/quser/internal/requestid"
 )
 
 type AuthClient struct {
 	baseURL    string
+	healthPath string
 	httpClient *http.Client
+	retry      RetryPolicy
+	breaker    *circuitBreaker    // nil이면 차단하지 않음
+	cache      *validationCache   // nil이면 캐시 미사용
+	inflight   singleflight.Group // 같은 토큰의 동시 검증 요청 병합
+	observer   CallObserver       // nil이면 기록하지 않음
+	logger     *slog.Logger
 }
 
+// CallObserver Auth 서비스 호출 결과를 전달받는 함수 (op는 엔드포인트 이름, 캐시 적중은 전달하지 않음)
+type CallObserver func(op string, duration time.Duration, err error)
+
 type AuthResponse struct {
 	AccessToken string `json:"access_token"`
 	TokenType   string `json:"token_type"`
@@ -22,22 +36,157 @@ type AuthResponse struct {
 }
 
 type TokenValidationResponse struct {
-	Valid  bool   `json:"valid"`
-	UserID string `json:"user_id"`
+	Valid     bool     `json:"valid"`
+	UserID    string   `json:"user_id"`
+	Roles     []string `json:"roles,omitempty"`
+	ExpiresAt int64    `json:"expires_at,omitempty"` // Unix 초
+	SessionID string   `json:"session_id,omitempty"`
 }
 
-// NewAuthClient Auth 클라이언트 생성자
-func NewAuthClient(baseURL string, timeout time.Duration) *AuthClient {
+// NewAuthClient Auth 클라이언트 생성자 (cacheSize나 cacheTTL이 0이면 검증 결과를 캐시하지 않음)
+func NewAuthClient(
+	baseURL, healthPath string,
+	timeout time.Duration,
+	cacheSize int,
+	cacheTTL time.Duration,
+	retry RetryPolicy,
+	breaker BreakerPolicy,
+	logger *slog.Logger,
+) *AuthClient {
+	logger = logger.With("component", "auth_client")
 	return &AuthClient{
-		baseURL: baseURL,
+		baseURL:    baseURL,
+		healthPath: healthPath,
 		httpClient: &http.Client{
 			Timeout: timeout,
+			// 요청마다 클라이언트 스팬을 만들고 traceparent 헤더를 주입
+			Transport: otelhttp.NewTransport(
+				http.DefaultTransport,
+				otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+					return "AuthService " + r.Method + " " + r.URL.Path
+				}),
+			),
 		},
+		retry:   retry,
+		breaker: newCircuitBreaker(breaker, logger),
+		cache:   newValidationCache(cacheSize, cacheTTL),
+		logger:  logger,
+	}
+}
+
+// CacheStats 토큰 검증 캐시 통계 (캐시 미사용 시 0)
+func (c *AuthClient) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return c.cache.stats()
+}
+
+// SetObserver 호출 결과 관찰자 등록 (요청 처리 시작 전에 호출)
+func (c *AuthClient) SetObserver(observer CallObserver) {
+	c.observer = observer
+}
+
+// observe 재시도를 포함한 호출 한 건의 결과를 관찰자에 전달
+func (c *AuthClient) observe(op string, start time.Time, err error) {
+	if c.observer != nil {
+		c.observer(op, time.Since(start), err)
+	}
+}
+
+// do 요청 실행 및 결과 로깅 (헤더와 본문은 토큰을 포함할 수 있으므로 기록하지 않음)
+//
+// 들어온 요청의 ID를 Auth 서비스로 전달하여 양쪽 로그를 연결할 수 있게 한다.
+func (c *AuthClient) do(req *http.Request, op string) (*http.Response, error) {
+	if id := requestid.FromContext(req.Context()); id != "" {
+		req.Header.Set(requestid.Header, id)
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.ErrorContext(req.Context(), "Auth 서비스 요청 실패",
+			"op", op,
+			"method", req.Method,
+			"path", req.URL.Path,
+			"duration", time.Since(start),
+			"error", err,
+		)
+		return nil, err
+	}
+
+	level := slog.LevelDebug
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		level = slog.LevelWarn
+	}
+	c.logger.Log(req.Context(), level, "Auth 서비스 응답",
+		"op", op,
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", resp.StatusCode,
+		"duration", time.Since(start),
+	)
+	return resp, nil
+}
+
+// send 재시도와 회로 차단을 적용한 요청 실행
+//
+// 연결 실패, 시간 초과, 5xx 응답은 Auth 서비스 장애로 기록하고 idempotent 요청만 재시도한다.
+// 에러가 없으면 5xx가 아닌 응답을 반환하며 호출 측이 본문을 닫아야 한다.
+func (c *AuthClient) send(req *http.Request, op string, idempotent bool) (*http.Response, error) {
+	ctx := req.Context()
+
+	attempts := 1
+	if idempotent {
+		attempts += c.retry.MaxRetries
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(ctx, c.retry.backoff(attempt)); err != nil {
+				return nil, lastErr
+			}
+		}
+
+		if !c.breaker.allow(time.Now()) {
+			// 재시도 중 차단기가 열렸다면 직전 실패 원인을 그대로 반환
+			if lastErr != nil {
+				return nil, lastErr
+			}
+			return nil, fmt.Errorf("%w: 회로 차단기 열림", ErrUnavailable)
+		}
+
+		resp, err := c.do(req.Clone(ctx), op)
+		if err != nil {
+			if ctx.Err() != nil {
+				// 호출 측 취소·기한 초과는 Auth 서비스 상태와 무관
+				c.breaker.release()
+				return nil, transportError(err)
+			}
+			c.breaker.failure(time.Now())
+			lastErr = transportError(err)
+			continue
+		}
+
+		if resp.StatusCode >= 500 {
+			resp.Body.Close()
+			c.breaker.failure(time.Now())
+			lastErr = fmt.Errorf("%w: %d", ErrUnavailable, resp.StatusCode)
+			continue
+		}
+
+		c.breaker.success()
+		return resp, nil
 	}
+	return nil, lastErr
 }
 
-// CreateToken 토큰 생성 요청
-func (c *AuthClient) CreateToken(ctx context.Context, userID string) (*AuthResponse, error) {
+// CreateToken 세션 토큰 생성 요청 (세션 ID는 토큰에 포함되어 검증 응답으로 돌아옴)
+func (c *AuthClient) CreateToken(ctx context.Context, userID, sessionID string) (_ *AuthResponse, err error) {
+	start := time.Now()
+	defer func() { c.observe("create_token", start, err) }()
+
 	req, err := http.NewRequestWithContext(
 		ctx,
 		"POST",
@@ -48,15 +197,20 @@ func (c *AuthClient) CreateToken(ctx context.Context, userID string) (*AuthRespo
 		return nil, fmt.Errorf("요청 생성 실패: %w", err)
 	}
 
-	// User ID를 헤더에 추가
+	// User ID와 세션 ID를 헤더에 추가
 	req.Header.Set("X-User-ID", userID)
+	req.Header.Set("X-Session-ID", sessionID)
 
-	resp, err := c.httpClient.Do(req)
+	// 재시도하면 토큰이 중복 발급될 수 있으므로 한 번만 요청
+	resp, err := c.send(req, "create_token", false)
 	if err != nil {
-		return nil, fmt.Errorf("요청 실패: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, ErrUnauthorized
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("토큰 생성 실패: %d", resp.StatusCode)
 	}
@@ -70,7 +224,46 @@ func (c *AuthClient) CreateToken(ctx context.Context, userID string) (*AuthRespo
 }
 
 // ValidateToken 토큰 검증
-func (c *AuthClient) ValidateToken(ctx context.Context, token string) error {
+//
+// 성공한 검증 결과는 캐시하여 재사용하고, 캐시에 없는 같은 토큰의 동시 요청은 Auth 서비스를 한 번만 호출한다.
+func (c *AuthClient) ValidateToken(ctx context.Context, token string) (*TokenValidationResponse, error) {
+	if c.cache == nil {
+		return c.validateToken(ctx, token)
+	}
+
+	key := tokenCacheKey(token)
+	if resp, ok := c.cache.get(key, time.Now()); ok {
+		return resp, nil
+	}
+
+	result := c.inflight.DoChan(string(key[:]), func() (interface{}, error) {
+		generation := c.cache.currentGeneration()
+
+		// 먼저 요청한 쪽이 취소되어도 함께 기다리는 요청은 계속 진행 (제한 시간은 httpClient.Timeout)
+		resp, err := c.validateToken(context.WithoutCancel(ctx), token)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.put(key, resp, generation, time.Now())
+		return resp, nil
+	})
+
+	select {
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*TokenValidationResponse).clone(), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// validateToken Auth 서비스에 토큰 검증 요청
+func (c *AuthClient) validateToken(ctx context.Context, token string) (_ *TokenValidationResponse, err error) {
+	start := time.Now()
+	defer func() { c.observe("validate_token", start, err) }()
+
 	req, err := http.NewRequestWithContext(
 		ctx,
 		"GET",
@@ -78,35 +271,49 @@ func (c *AuthClient) ValidateToken(ctx context.Context, token string) error {
 		nil,
 	)
 	if err != nil {
-		return fmt.Errorf("요청 생성 실패: %w", err)
+		return nil, fmt.Errorf("요청 생성 실패: %w", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req, "validate_token", true)
 	if err != nil {
-		return fmt.Errorf("요청 실패: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, ErrUnauthorized
+	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("토큰 검증 실패: %d", resp.StatusCode)
+		return nil, fmt.Errorf("토큰 검증 실패: %d", resp.StatusCode)
 	}
 
 	var validationResp TokenValidationResponse
 	if err := json.NewDecoder(resp.Body).Decode(&validationResp); err != nil {
-		return fmt.Errorf("응답 파싱 실패: %w", err)
+		return nil, fmt.Errorf("응답 파싱 실패: %w", err)
 	}
 
-	if !validationResp.Valid {
-		return fmt.Errorf("유효하지 않은 토큰")
+	if !validationResp.Valid || validationResp.UserID == "" {
+		return nil, ErrUnauthorized
 	}
 
-	return nil
+	return &validationResp, nil
 }
 
 // RevokeToken 토큰 폐기 요청
-func (c *AuthClient) RevokeToken(ctx context.Context, token string) error {
+//
+// 캐시 항목은 요청 전에 바로 제거하고, 요청 중 다시 저장되었을 수 있으므로 끝난 뒤에도 한 번 더 제거한다.
+func (c *AuthClient) RevokeToken(ctx context.Context, token string) (err error) {
+	start := time.Now()
+	defer func() { c.observe("revoke_token", start, err) }()
+
+	if c.cache != nil {
+		key := tokenCacheKey(token)
+		c.cache.evict(key)
+		defer c.cache.evict(key)
+	}
+
 	req, err := http.NewRequestWithContext(
 		ctx,
 		"POST",
@@ -119,17 +326,125 @@ func (c *AuthClient) RevokeToken(ctx context.Context, token string) error {
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
-	resp, err := c.httpClient.Do(req)
+	// 폐기 요청은 여러 번 보내도 결과가 같으므로 재시도
+	resp, err := c.send(req, "revoke_token", true)
 	if err != nil {
-		return fmt.Errorf("요청 실패: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
 	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusUnauthorized {
-			return domain.ErrInvalidToken
-		}
-		return domain.ErrLogoutFailed
+		return fmt.Errorf("토큰 폐기 실패: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// RevokeSession 세션에 발급된 토큰 폐기 요청
+func (c *AuthClient) RevokeSession(ctx context.Context, userID, sessionID string) (err error) {
+	start := time.Now()
+	defer func() { c.observe("revoke_session", start, err) }()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/api/auth/session/revoke", c.baseURL),
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	req.Header.Set("X-User-ID", userID)
+	req.Header.Set("X-Session-ID", sessionID)
+
+	if c.cache != nil {
+		defer c.cache.evictMatching(func(v *TokenValidationResponse) bool {
+			return v.UserID == userID && v.SessionID == sessionID
+		})
+	}
+
+	resp, err := c.send(req, "revoke_session", true)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("세션 폐기 실패: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// RevokeAllUserTokens 사용자의 모든 토큰 폐기 요청
+func (c *AuthClient) RevokeAllUserTokens(ctx context.Context, userID string) (err error) {
+	start := time.Now()
+	defer func() { c.observe("revoke_all_user_tokens", start, err) }()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/api/auth/token/revoke-all", c.baseURL),
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	req.Header.Set("X-User-ID", userID)
+
+	if c.cache != nil {
+		defer c.cache.evictMatching(func(v *TokenValidationResponse) bool {
+			return v.UserID == userID
+		})
+	}
+
+	resp, err := c.send(req, "revoke_all_user_tokens", true)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("토큰 일괄 폐기 실패: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Ping Auth 서비스 접근 가능 여부 확인 (실제 상태를 보고하도록 재시도와 회로 차단기를 거치지 않음)
+func (c *AuthClient) Ping(ctx context.Context) (err error) {
+	start := time.Now()
+	defer func() { c.observe("ping", start, err) }()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.baseURL+c.healthPath,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	resp, err := c.do(req, "ping")
+	if err != nil {
+		return fmt.Errorf("요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Auth 서비스 상태 이상: %d", resp.StatusCode)
 	}
 
 	return nil
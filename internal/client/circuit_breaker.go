@@ -0,0 +1,119 @@
+// quser/internal/client/circuit_breaker.go
+package client
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// BreakerPolicy 회로 차단기 정책 (Threshold가 0 이하이면 미사용)
+type BreakerPolicy struct {
+	Threshold    int           // 차단기를 여는 연속 실패 횟수
+	OpenDuration time.Duration // 열린 뒤 시험 요청을 허용하기까지 대기 시간
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker 연속 실패 시 Auth 서비스 호출을 일정 시간 차단
+//
+// 열린 상태에서 OpenDuration이 지나면 요청 하나만 시험 삼아 보내고,
+// 성공하면 닫고 실패하면 다시 연다.
+type circuitBreaker struct {
+	policy BreakerPolicy
+	logger *slog.Logger
+
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(policy BreakerPolicy, logger *slog.Logger) *circuitBreaker {
+	if policy.Threshold <= 0 {
+		return nil
+	}
+	return &circuitBreaker{policy: policy, logger: logger}
+}
+
+// allow 요청 허용 여부 (nil이면 항상 허용)
+func (b *circuitBreaker) allow(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if now.Before(b.openUntil) {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// success 요청 성공 기록 (시험 요청이 성공하면 차단기를 닫음)
+func (b *circuitBreaker) success() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != breakerClosed {
+		b.logger.Info("Auth 서비스 회로 차단기 닫힘")
+	}
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// failure 요청 실패 기록 (연속 실패가 기준에 도달하거나 시험 요청이 실패하면 차단기를 엶)
+func (b *circuitBreaker) failure(now time.Time) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.policy.Threshold {
+		if b.state != breakerOpen {
+			b.logger.Warn("Auth 서비스 회로 차단기 열림", "failures", b.failures, "open_duration", b.policy.OpenDuration)
+		}
+		b.state = breakerOpen
+		b.openUntil = now.Add(b.policy.OpenDuration)
+		b.probing = false
+	}
+}
+
+// release 결과를 판단할 수 없는 요청(호출 측 취소) 후 시험 요청 자리를 반환
+func (b *circuitBreaker) release() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
@@ -0,0 +1,32 @@
+// quser/internal/client/errors.go
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+var (
+	// ErrUnauthorized Auth 서비스가 토큰이나 요청을 거부함 (401)
+	ErrUnauthorized = errors.New("Auth 서비스 인증 실패")
+	// ErrUnavailable Auth 서비스에 연결할 수 없거나 5xx 응답, 또는 회로 차단기가 열림
+	ErrUnavailable = errors.New("Auth 서비스를 사용할 수 없습니다")
+	// ErrTimeout Auth 서비스 응답 시간 초과
+	ErrTimeout = errors.New("Auth 서비스 응답 시간 초과")
+)
+
+// IsUnavailable Auth 서비스 장애로 인한 에러인지 확인 (요청 자체의 문제가 아니므로 재시도 가능)
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrUnavailable) || errors.Is(err, ErrTimeout)
+}
+
+// transportError 요청 전송 실패를 시간 초과와 연결 실패로 구분
+func transportError(err error) error {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return fmt.Errorf("%w: %w", ErrTimeout, err)
+	}
+	return fmt.Errorf("%w: %w", ErrUnavailable, err)
+}
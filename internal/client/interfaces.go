@@ -0,0 +1,20 @@
+// quser/internal/client/interfaces.go
+package client
+
+import "context"
+
+// AuthService Auth 서비스 클라이언트 인터페이스 정의
+type AuthService interface {
+	// 세션 토큰 생성
+	CreateToken(ctx context.Context, userID, sessionID string) (*AuthResponse, error)
+	// 토큰 검증
+	ValidateToken(ctx context.Context, token string) (*TokenValidationResponse, error)
+	// 토큰 폐기
+	RevokeToken(ctx context.Context, token string) error
+	// 세션에 발급된 토큰 폐기
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	// 사용자의 모든 토큰 폐기
+	RevokeAllUserTokens(ctx context.Context, userID string) error
+	// Auth 서비스 접근 가능 여부 확인
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,49 @@
+// quser/internal/client/retry.go
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy 멱등 요청 재시도 정책 (MaxRetries가 0이면 재시도하지 않음)
+type RetryPolicy struct {
+	MaxRetries int           // 첫 요청 이후 추가 시도 횟수
+	BaseDelay  time.Duration // 첫 재시도 대기 시간 상한 (이후 두 배씩 증가)
+	MaxDelay   time.Duration // 재시도 대기 시간 상한
+}
+
+// backoff attempt번째 재시도 전 대기 시간 (full jitter: 0 ~ 상한 사이 임의 값)
+//
+// 여러 인스턴스가 동시에 실패해도 재시도가 한꺼번에 몰리지 않도록 무작위로 분산한다.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	limit := p.BaseDelay
+	for i := 1; i < attempt && limit < p.MaxDelay; i++ {
+		limit *= 2
+	}
+	if p.MaxDelay > 0 && limit > p.MaxDelay {
+		limit = p.MaxDelay
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(limit) + 1))
+}
+
+// sleepContext ctx가 취소되면 즉시 반환하는 대기
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,173 @@
+// quser/internal/client/validation_cache.go
+package client
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheStats 토큰 검증 캐시 누적 통계
+type CacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// cacheKey 토큰 원문 대신 보관하는 SHA-256 해시
+type cacheKey [sha256.Size]byte
+
+func tokenCacheKey(token string) cacheKey {
+	return sha256.Sum256([]byte(token))
+}
+
+type cacheEntry struct {
+	key       cacheKey
+	value     TokenValidationResponse
+	expiresAt time.Time
+}
+
+// validationCache 토큰 검증 결과 LRU 캐시
+//
+// 항목은 설정된 TTL과 토큰 만료 시각 중 이른 시점에 만료되고, 용량을 넘으면 가장 오래 사용되지 않은 항목부터 제거한다.
+// 폐기와 검증이 동시에 진행될 때 폐기 전에 시작된 검증 결과가 다시 저장되지 않도록 제거할 때마다 세대를 올린다.
+type validationCache struct {
+	capacity int
+	ttl      time.Duration
+
+	mu         sync.Mutex
+	entries    map[cacheKey]*list.Element
+	order      *list.List // 앞쪽이 최근 사용
+	generation uint64
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// newValidationCache 캐시 생성자 (capacity나 ttl이 0 이하이면 nil을 반환하여 캐시 미사용)
+func newValidationCache(capacity int, ttl time.Duration) *validationCache {
+	if capacity <= 0 || ttl <= 0 {
+		return nil
+	}
+	return &validationCache{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  make(map[cacheKey]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// get 유효한 항목 조회 (반환값은 복사본)
+func (c *validationCache) get(key cacheKey, now time.Time) (*TokenValidationResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.removeElement(elem)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	c.hits.Add(1)
+	return entry.value.clone(), true
+}
+
+// currentGeneration 검증 요청 시작 시점의 세대 (put에 전달)
+func (c *validationCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// put 검증 결과 저장 (generation 이후 제거가 있었다면 저장하지 않음)
+func (c *validationCache) put(key cacheKey, value *TokenValidationResponse, generation uint64, now time.Time) {
+	expiresAt := now.Add(c.ttl)
+	if value.ExpiresAt > 0 {
+		if tokenExpiry := time.Unix(value.ExpiresAt, 0); tokenExpiry.Before(expiresAt) {
+			expiresAt = tokenExpiry
+		}
+	}
+	if !now.Before(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.value = *value.clone()
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: *value.clone(), expiresAt: expiresAt})
+	for c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// evict 토큰 항목 제거
+func (c *validationCache) evict(key cacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// evictMatching 조건에 맞는 모든 항목 제거 (세션·사용자 단위 폐기용)
+func (c *validationCache) evictMatching(match func(*TokenValidationResponse) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		if match(&elem.Value.(*cacheEntry).value) {
+			c.removeElement(elem)
+		}
+		elem = next
+	}
+}
+
+func (c *validationCache) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
+
+func (c *validationCache) stats() CacheStats {
+	c.mu.Lock()
+	entries := c.order.Len()
+	c.mu.Unlock()
+
+	return CacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: entries,
+	}
+}
+
+func (r *TokenValidationResponse) clone() *TokenValidationResponse {
+	cloned := *r
+	cloned.Roles = slices.Clone(r.Roles)
+	return &cloned
+}
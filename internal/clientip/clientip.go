@@ -0,0 +1,51 @@
+// quser/internal/clientip/clientip.go
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type contextKey struct{}
+
+// NewContext 클라이언트 IP를 담은 컨텍스트 반환
+func NewContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, contextKey{}, ip)
+}
+
+// FromContext 컨텍스트의 클라이언트 IP (없으면 빈 문자열)
+func FromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(contextKey{}).(string)
+	return ip
+}
+
+// FromRequest 요청의 클라이언트 IP 결정
+//
+// trustedHops는 서비스 앞단의 신뢰할 수 있는 프록시 수다. 0이면 X-Forwarded-For를 무시하고
+// 연결 주소를 사용하며, n이면 프록시가 덧붙인 오른쪽에서 n번째 값을 사용한다.
+// 클라이언트가 임의로 넣은 왼쪽 값은 신뢰하지 않는다.
+func FromRequest(r *http.Request, trustedHops int) string {
+	if trustedHops > 0 {
+		var hops []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			for _, hop := range strings.Split(header, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) >= trustedHops {
+			if ip := net.ParseIP(hops[len(hops)-trustedHops]); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
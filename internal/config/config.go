@@ -2,22 +2,42 @@
 package config
 
 import (
+    "fmt"
     "os"
     "strconv"
+    "strings"
     "time"
     "github.com/joho/godotenv"
 )
 
 type Config struct {
-    Server      ServerConfig
-    MongoDB     MongoDBConfig
-    AuthService AuthServiceConfig
-    LogLevel    string
+    Server        ServerConfig
+    MongoDB       MongoDBConfig
+    AuthService   AuthServiceConfig
+    TokenVerify   TokenVerifyConfig
+    Health        HealthConfig
+    CORS          CORSConfig
+    RateLimit     RateLimitConfig
+    Lockout       LockoutConfig
+    LoginHistory  LoginHistoryConfig
+    Session       SessionConfig
+    Password      PasswordConfig
+    Mail          MailConfig
+    Verification  VerificationConfig
+    PasswordReset PasswordResetConfig
+    Deletion      DeletionConfig
+    Email         EmailConfig
+    Tracing       TracingConfig
+    LogLevel      string
+    LogFormat     string
 }
 
 type ServerConfig struct {
-    Host string
-    Port string
+    Host             string
+    Port             string
+    ShutdownTimeout  time.Duration // 종료 신호 후 드레인 대기, 진행 중인 요청, 종료 작업을 모두 마쳐야 하는 기한
+    DrainDelay       time.Duration // not-ready 전환 후 로드밸런서가 반영할 때까지 대기하는 시간
+    TrustedProxyHops int           // 앞단의 신뢰할 수 있는 프록시 수 (0이면 X-Forwarded-For 무시)
 }
 
 type MongoDBConfig struct {
@@ -26,8 +46,145 @@ type MongoDBConfig struct {
 }
 
 type AuthServiceConfig struct {
-    URL     string
-    Timeout time.Duration
+    URL        string
+    HealthPath string
+    Timeout    time.Duration
+    // 토큰 검증 결과 캐시 (0이면 미사용, 항목은 TTL과 토큰 만료 중 이른 시점에 만료)
+    TokenCacheSize int
+    TokenCacheTTL  time.Duration
+    // 멱등 요청 재시도 (지수 백오프 + 지터)
+    MaxRetries     int
+    RetryBaseDelay time.Duration
+    RetryMaxDelay  time.Duration
+    // 연속 실패 시 일정 시간 호출 차단 (0이면 미사용)
+    BreakerThreshold    int
+    BreakerOpenDuration time.Duration
+}
+
+// TokenVerifyConfig 액세스 토큰 검증 설정
+type TokenVerifyConfig struct {
+    // remote: 요청마다 Auth 서비스에 검증 요청
+    // local: Auth 서비스의 JWKS로 서명을 직접 검증하고 폐기 목록은 주기적으로 동기화
+    Mode                 string
+    JWKSPath             string
+    JWKSRefreshInterval  time.Duration // 키 교체를 반영하기 위한 JWKS 재조회 주기
+    Issuer               string        // 비어 있으면 iss 확인 생략
+    Audience             string        // 비어 있으면 aud 확인 생략
+    Leeway               time.Duration // exp/nbf/iat 확인 시 허용하는 시계 오차
+    DenylistPath         string
+    DenylistSyncInterval time.Duration
+    // 마지막 동기화 후 이 시간이 지나면 폐기 여부를 알 수 없는 것으로 보고 검증 거부 (503)
+    DenylistMaxStaleness time.Duration
+}
+
+// HealthConfig 상태 확인 설정
+type HealthConfig struct {
+    CacheTTL     time.Duration // 프로브 결과 캐시 시간
+    CheckTimeout time.Duration // 의존성 확인 제한 시간
+}
+
+// CORSConfig 교차 출처 요청 허용 정책
+type CORSConfig struct {
+    // 허용 출처 목록 ("https://*.example.com" 형태의 와일드카드 서브도메인, "*"는 모든 출처)
+    AllowedOrigins   []string
+    AllowedMethods   []string
+    AllowedHeaders   []string
+    ExposedHeaders   []string
+    AllowCredentials bool
+    MaxAge           time.Duration // 프리플라이트 응답 캐시 시간
+}
+
+// RateLimitConfig 공개 엔드포인트(로그인, 회원가입, 이메일 인증, 비밀번호 재설정) 요청 제한 설정 (0이면 해당 제한 미적용)
+type RateLimitConfig struct {
+    Store            string // memory | mongodb (여러 인스턴스가 상태를 공유할 때)
+    LoginPerIP       int    // IP당 분당 로그인 요청 수
+    LoginPerEmail    int    // 이메일당 분당 로그인 요청 수
+    LoginPerIPEmail  int    // IP+이메일 조합당 분당 로그인 요청 수
+    RegisterPerIP    int    // IP당 시간당 회원가입 요청 수
+    RegisterPerEmail int    // 이메일당 시간당 회원가입 요청 수
+    VerifyPerIP      int    // IP당 분당 이메일 인증 요청 수 (토큰 추측 방지)
+    ResendPerIP      int    // IP당 시간당 인증 메일 재발송 요청 수
+    ResendPerEmail   int    // 이메일당 시간당 인증 메일 재발송 요청 수
+    ForgotPerIP      int    // IP당 시간당 비밀번호 재설정 메일 요청 수
+    ForgotPerEmail   int    // 이메일당 시간당 비밀번호 재설정 메일 요청 수
+    ResetPerIP       int    // IP당 분당 비밀번호 재설정 요청 수 (토큰 추측 방지)
+}
+
+// LockoutConfig 로그인 실패 잠금 설정
+type LockoutConfig struct {
+    Threshold    int           // 잠금을 시작하는 연속 실패 횟수
+    BaseDuration time.Duration // 첫 잠금 시간 (이후 실패마다 두 배)
+    MaxDuration  time.Duration // 최대 잠금 시간
+    // 마지막 실패 후 이 시간이 지나면 실패 횟수를 새로 센다
+    // MaxDuration보다 짧으면 잠금이 풀린 뒤 횟수가 초기화되어 잠금 시간이 늘어나지 않는다
+    ResetAfter time.Duration
+}
+
+// LoginHistoryConfig 로그인 기록 설정
+type LoginHistoryConfig struct {
+    Retention time.Duration // 보관 기간 (이후 TTL 인덱스로 삭제)
+}
+
+// SessionConfig 로그인 세션 설정
+type SessionConfig struct {
+    TouchInterval time.Duration // 마지막 활동 시각 갱신 최소 간격
+    DefaultTTL    time.Duration // Auth 서비스가 만료 시간을 주지 않을 때의 세션 유지 시간
+}
+
+// MailConfig 메일 발송 설정
+type MailConfig struct {
+    Driver       string // smtp 또는 log
+    From         string
+    SMTPHost     string
+    SMTPPort     string
+    SMTPUsername string
+    SMTPPassword string
+    LogFile      string // log 드라이버 사용 시 출력 파일 (비어 있으면 표준 로그)
+}
+
+// VerificationConfig 이메일 인증 설정
+type VerificationConfig struct {
+    BaseURL          string // 인증 링크에 사용할 외부 접근 URL
+    TokenTTL         time.Duration
+    ResendCooldown   time.Duration
+    ResendMaxPerHour int
+}
+
+// PasswordResetConfig 비밀번호 재설정 설정
+type PasswordResetConfig struct {
+    URL             string // 재설정 화면 URL (토큰이 쿼리로 추가됨)
+    TokenTTL        time.Duration
+    RequestCooldown time.Duration
+}
+
+// DeletionConfig 계정 삭제 설정
+type DeletionConfig struct {
+    GracePeriod    time.Duration // 복구 가능 기간
+    PurgeInterval  time.Duration // 영구 삭제 작업 주기
+    PurgeBatchSize int
+}
+
+// EmailConfig 이메일 정규화 설정
+type EmailConfig struct {
+    // Gmail 점/플러스 태그 등 제공자별 규칙 적용 여부
+    // 변경 시 기존 email_normalized 값을 재계산해야 한다
+    ProviderRules bool
+}
+
+// TracingConfig OpenTelemetry 트레이싱 설정
+type TracingConfig struct {
+    Enabled      bool
+    ServiceName  string
+    Exporter     string // otlp | stdout (로컬 디버깅용, 로그와 같은 스트림에 출력)
+    OTLPEndpoint string
+    SampleRatio  float64 // 부모 스팬이 없는 요청의 샘플링 비율 (0~1)
+}
+
+// PasswordConfig argon2id 비밀번호 해시 파라미터
+type PasswordConfig struct {
+    Argon2Memory      uint32 // KiB 단위
+    Argon2Iterations  uint32
+    Argon2Parallelism uint8
 }
 
 func LoadConfig() (*Config, error) {
@@ -40,21 +197,165 @@ func LoadConfig() (*Config, error) {
         timeoutSec = 5
     }
 
-    return &Config{
+    cfg := &Config{
         Server: ServerConfig{
-            Host: getEnv("SERVER_HOST", "0.0.0.0"),
-            Port: getEnv("SERVER_PORT", "8081"), // Auth는 8080, User는 8081 사용
+            Host:             getEnv("SERVER_HOST", "0.0.0.0"),
+            Port:             getEnv("SERVER_PORT", "8081"), // Auth는 8080, User는 8081 사용
+            ShutdownTimeout:  time.Duration(getEnvInt("SERVER_SHUTDOWN_TIMEOUT_SEC", 25)) * time.Second,
+            DrainDelay:       time.Duration(getEnvInt("SERVER_DRAIN_DELAY_SEC", 5)) * time.Second,
+            TrustedProxyHops: getEnvInt("SERVER_TRUSTED_PROXY_HOPS", 0),
         },
         MongoDB: MongoDBConfig{
             URI:      getEnv("MONGODB_URI", "mongodb://localhost:27017"),
             Database: getEnv("MONGODB_DATABASE", "user_db"),
         },
         AuthService: AuthServiceConfig{
-            URL:     getEnv("AUTH_SERVICE_URL", "http://localhost:8080"),
-            Timeout: time.Duration(timeoutSec) * time.Second,
+            URL:                 getEnv("AUTH_SERVICE_URL", "http://localhost:8080"),
+            HealthPath:          getEnv("AUTH_SERVICE_HEALTH_PATH", "/health"),
+            Timeout:             time.Duration(timeoutSec) * time.Second,
+            TokenCacheSize:      getEnvInt("AUTH_SERVICE_TOKEN_CACHE_SIZE", 10000),
+            TokenCacheTTL:       time.Duration(getEnvInt("AUTH_SERVICE_TOKEN_CACHE_TTL_SEC", 30)) * time.Second,
+            MaxRetries:          getEnvInt("AUTH_SERVICE_MAX_RETRIES", 2),
+            RetryBaseDelay:      time.Duration(getEnvInt("AUTH_SERVICE_RETRY_BASE_DELAY_MS", 100)) * time.Millisecond,
+            RetryMaxDelay:       time.Duration(getEnvInt("AUTH_SERVICE_RETRY_MAX_DELAY_MS", 1000)) * time.Millisecond,
+            BreakerThreshold:    getEnvInt("AUTH_SERVICE_BREAKER_THRESHOLD", 5),
+            BreakerOpenDuration: time.Duration(getEnvInt("AUTH_SERVICE_BREAKER_OPEN_SEC", 30)) * time.Second,
+        },
+        TokenVerify: TokenVerifyConfig{
+            Mode:                 getEnv("TOKEN_VERIFY_MODE", "remote"),
+            JWKSPath:             getEnv("TOKEN_VERIFY_JWKS_PATH", "/.well-known/jwks.json"),
+            JWKSRefreshInterval:  time.Duration(getEnvInt("TOKEN_VERIFY_JWKS_REFRESH_INTERVAL_SEC", 3600)) * time.Second,
+            Issuer:               getEnv("TOKEN_VERIFY_ISSUER", ""),
+            Audience:             getEnv("TOKEN_VERIFY_AUDIENCE", ""),
+            Leeway:               time.Duration(getEnvInt("TOKEN_VERIFY_LEEWAY_SEC", 30)) * time.Second,
+            DenylistPath:         getEnv("TOKEN_VERIFY_DENYLIST_PATH", "/api/auth/token/denylist"),
+            DenylistSyncInterval: time.Duration(getEnvInt("TOKEN_VERIFY_DENYLIST_SYNC_INTERVAL_SEC", 15)) * time.Second,
+            DenylistMaxStaleness: time.Duration(getEnvInt("TOKEN_VERIFY_DENYLIST_MAX_STALENESS_SEC", 60)) * time.Second,
+        },
+        Health: HealthConfig{
+            CacheTTL:     time.Duration(getEnvInt("HEALTH_CACHE_TTL_MS", 2000)) * time.Millisecond,
+            CheckTimeout: time.Duration(getEnvInt("HEALTH_CHECK_TIMEOUT_MS", 2000)) * time.Millisecond,
+        },
+        RateLimit: RateLimitConfig{
+            Store:            getEnv("RATE_LIMIT_STORE", "memory"),
+            LoginPerIP:       getEnvInt("RATE_LIMIT_LOGIN_PER_IP_PER_MIN", 30),
+            LoginPerEmail:    getEnvInt("RATE_LIMIT_LOGIN_PER_EMAIL_PER_MIN", 10),
+            LoginPerIPEmail:  getEnvInt("RATE_LIMIT_LOGIN_PER_IP_EMAIL_PER_MIN", 5),
+            RegisterPerIP:    getEnvInt("RATE_LIMIT_REGISTER_PER_IP_PER_HOUR", 20),
+            RegisterPerEmail: getEnvInt("RATE_LIMIT_REGISTER_PER_EMAIL_PER_HOUR", 5),
+            VerifyPerIP:      getEnvInt("RATE_LIMIT_VERIFY_PER_IP_PER_MIN", 30),
+            ResendPerIP:      getEnvInt("RATE_LIMIT_RESEND_PER_IP_PER_HOUR", 20),
+            ResendPerEmail:   getEnvInt("RATE_LIMIT_RESEND_PER_EMAIL_PER_HOUR", 5),
+            ForgotPerIP:      getEnvInt("RATE_LIMIT_FORGOT_PER_IP_PER_HOUR", 20),
+            ForgotPerEmail:   getEnvInt("RATE_LIMIT_FORGOT_PER_EMAIL_PER_HOUR", 5),
+            ResetPerIP:       getEnvInt("RATE_LIMIT_RESET_PER_IP_PER_MIN", 10),
+        },
+        Lockout: LockoutConfig{
+            Threshold:    getEnvInt("LOGIN_LOCKOUT_THRESHOLD", 5),
+            BaseDuration: time.Duration(getEnvInt("LOGIN_LOCKOUT_BASE_SEC", 60)) * time.Second,
+            MaxDuration:  time.Duration(getEnvInt("LOGIN_LOCKOUT_MAX_MIN", 60)) * time.Minute,
+            ResetAfter:   time.Duration(getEnvInt("LOGIN_LOCKOUT_RESET_AFTER_MIN", 1440)) * time.Minute,
+        },
+        LoginHistory: LoginHistoryConfig{
+            Retention: time.Duration(getEnvInt("LOGIN_HISTORY_RETENTION_DAYS", 90)) * 24 * time.Hour,
+        },
+        Session: SessionConfig{
+            TouchInterval: time.Duration(getEnvInt("SESSION_TOUCH_INTERVAL_SEC", 60)) * time.Second,
+            DefaultTTL:    time.Duration(getEnvInt("SESSION_DEFAULT_TTL_HOURS", 24)) * time.Hour,
+        },
+        CORS: CORSConfig{
+            AllowedOrigins:   getEnvList("CORS_ALLOWED_ORIGINS", nil),
+            AllowedMethods:   getEnvList("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE"}),
+            AllowedHeaders:   getEnvList("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization", "Accept-Language", "X-Request-ID"}),
+            ExposedHeaders:   getEnvList("CORS_EXPOSED_HEADERS", []string{"X-Request-ID"}),
+            AllowCredentials: getEnvBool("CORS_ALLOW_CREDENTIALS", false),
+            MaxAge:           time.Duration(getEnvInt("CORS_MAX_AGE_SEC", 600)) * time.Second,
+        },
+        Password: PasswordConfig{
+            Argon2Memory:      uint32(getEnvInt("PASSWORD_ARGON2_MEMORY_KB", 64*1024)),
+            Argon2Iterations:  uint32(getEnvInt("PASSWORD_ARGON2_ITERATIONS", 3)),
+            Argon2Parallelism: uint8(getEnvInt("PASSWORD_ARGON2_PARALLELISM", 2)),
+        },
+        Mail: MailConfig{
+            Driver:       getEnv("MAIL_DRIVER", "log"),
+            From:         getEnv("MAIL_FROM", "no-reply@localhost"),
+            SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+            SMTPPort:     getEnv("SMTP_PORT", "587"),
+            SMTPUsername: getEnv("SMTP_USERNAME", ""),
+            SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+            LogFile:      getEnv("MAIL_LOG_FILE", ""),
+        },
+        Verification: VerificationConfig{
+            BaseURL:          getEnv("APP_BASE_URL", "http://localhost:8081"),
+            TokenTTL:         time.Duration(getEnvInt("VERIFICATION_TOKEN_TTL_MIN", 24*60)) * time.Minute,
+            ResendCooldown:   time.Duration(getEnvInt("VERIFICATION_RESEND_COOLDOWN_SEC", 60)) * time.Second,
+            ResendMaxPerHour: getEnvInt("VERIFICATION_RESEND_MAX_PER_HOUR", 5),
+        },
+        PasswordReset: PasswordResetConfig{
+            URL:             getEnv("PASSWORD_RESET_URL", "http://localhost:3000/password/reset"),
+            TokenTTL:        time.Duration(getEnvInt("PASSWORD_RESET_TOKEN_TTL_MIN", 30)) * time.Minute,
+            RequestCooldown: time.Duration(getEnvInt("PASSWORD_RESET_COOLDOWN_SEC", 60)) * time.Second,
+        },
+        Deletion: DeletionConfig{
+            GracePeriod:    time.Duration(getEnvInt("ACCOUNT_DELETION_GRACE_PERIOD_HOURS", 30*24)) * time.Hour,
+            PurgeInterval:  time.Duration(getEnvInt("ACCOUNT_PURGE_INTERVAL_MIN", 60)) * time.Minute,
+            PurgeBatchSize: getEnvInt("ACCOUNT_PURGE_BATCH_SIZE", 100),
+        },
+        Email: EmailConfig{
+            ProviderRules: getEnvBool("EMAIL_PROVIDER_RULES", false),
+        },
+        Tracing: TracingConfig{
+            Enabled:      getEnvBool("TRACING_ENABLED", false),
+            ServiceName:  getEnv("OTEL_SERVICE_NAME", "quser"),
+            Exporter:     getEnv("TRACING_EXPORTER", "otlp"),
+            OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+            SampleRatio:  getEnvFloat("TRACING_SAMPLE_RATIO", 1.0),
         },
-        LogLevel: getEnv("LOG_LEVEL", "debug"),
-    }, nil
+        LogLevel:  getEnv("LOG_LEVEL", "debug"),
+        LogFormat: getEnv("LOG_FORMAT", "text"),
+    }
+
+    if err := cfg.validate(); err != nil {
+        return nil, err
+    }
+    return cfg, nil
+}
+
+// validate 기동 후에야 드러나는 잘못된 설정 조합을 미리 거부
+func (c *Config) validate() error {
+    // 드레인 대기는 종료 기한 안에서 진행되므로 남는 시간이 있어야 요청 처리와 종료 작업을 마칠 수 있음
+    if c.Server.DrainDelay >= c.Server.ShutdownTimeout {
+        return fmt.Errorf("SERVER_DRAIN_DELAY_SEC(%s)는 SERVER_SHUTDOWN_TIMEOUT_SEC(%s)보다 짧아야 합니다", c.Server.DrainDelay, c.Server.ShutdownTimeout)
+    }
+
+    // argon2id는 반복 횟수나 병렬도가 0이면 해시 시점에 패닉하고, 메모리는 병렬도당 8KiB 이상이어야 함
+    if c.Password.Argon2Iterations == 0 {
+        return fmt.Errorf("PASSWORD_ARGON2_ITERATIONS는 0보다 커야 합니다")
+    }
+    if c.Password.Argon2Parallelism == 0 {
+        return fmt.Errorf("PASSWORD_ARGON2_PARALLELISM은 0보다 커야 합니다")
+    }
+    if c.Password.Argon2Memory < 8*uint32(c.Password.Argon2Parallelism) {
+        return fmt.Errorf("PASSWORD_ARGON2_MEMORY_KB(%d)는 병렬도의 8배(%d) 이상이어야 합니다", c.Password.Argon2Memory, 8*uint32(c.Password.Argon2Parallelism))
+    }
+
+    // 주기 작업은 time.NewTicker에 그대로 전달되므로 0 이하이면 기동 중 패닉
+    if c.Deletion.PurgeInterval <= 0 {
+        return fmt.Errorf("ACCOUNT_PURGE_INTERVAL_MIN은 0보다 커야 합니다")
+    }
+    if c.TokenVerify.Mode == "local" {
+        if c.TokenVerify.JWKSRefreshInterval <= 0 {
+            return fmt.Errorf("TOKEN_VERIFY_JWKS_REFRESH_INTERVAL_SEC는 0보다 커야 합니다")
+        }
+        if c.TokenVerify.DenylistSyncInterval <= 0 {
+            return fmt.Errorf("TOKEN_VERIFY_DENYLIST_SYNC_INTERVAL_SEC는 0보다 커야 합니다")
+        }
+        // 허용 시간이 동기화 주기보다 짧으면 정상 동작 중에도 검증이 거부됨
+        if c.TokenVerify.DenylistMaxStaleness <= c.TokenVerify.DenylistSyncInterval {
+            return fmt.Errorf("TOKEN_VERIFY_DENYLIST_MAX_STALENESS_SEC(%s)는 TOKEN_VERIFY_DENYLIST_SYNC_INTERVAL_SEC(%s)보다 길어야 합니다", c.TokenVerify.DenylistMaxStaleness, c.TokenVerify.DenylistSyncInterval)
+        }
+    }
+    return nil
 }
 
 func getEnv(key, defaultValue string) string {
@@ -63,4 +364,44 @@ func getEnv(key, defaultValue string) string {
         return defaultValue
     }
     return value
-}
\ No newline at end of file
+}
+
+func getEnvInt(key string, defaultValue int) int {
+    value, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+    if err != nil {
+        return defaultValue
+    }
+    return value
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+    value, err := strconv.ParseFloat(getEnv(key, strconv.FormatFloat(defaultValue, 'f', -1, 64)), 64)
+    if err != nil {
+        return defaultValue
+    }
+    return value
+}
+
+// getEnvList 쉼표로 구분된 목록 (빈 항목 제외)
+func getEnvList(key string, defaultValue []string) []string {
+    value := os.Getenv(key)
+    if value == "" {
+        return defaultValue
+    }
+
+    var items []string
+    for _, item := range strings.Split(value, ",") {
+        if item = strings.TrimSpace(item); item != "" {
+            items = append(items, item)
+        }
+    }
+    return items
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+    value, err := strconv.ParseBool(getEnv(key, strconv.FormatBool(defaultValue)))
+    if err != nil {
+        return defaultValue
+    }
+    return value
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/signalable/quser/internal/delivery/http/response"
+	"github.com/signalable/quser/internal/health"
+	"github.com/signalable/quser/internal/lifecycle"
+)
+
+type HealthHandler struct {
+	healthService *health.Service
+	lifecycle     *lifecycle.Manager
+}
+
+// NewHealthHandler Health 핸들러 생성자
+func NewHealthHandler(healthService *health.Service, lifecycle *lifecycle.Manager) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+		lifecycle:     lifecycle,
+	}
+}
+
+// Liveness 프로세스 생존 확인 핸들러 (의존성은 확인하지 않음)
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, map[string]string{
+		"status": health.StatusUp,
+	})
+}
+
+// Readiness 트래픽 수신 가능 여부 확인 핸들러
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	// 종료 중에는 의존성 확인 없이 바로 not-ready
+	if !h.lifecycle.Ready() {
+		response.JSON(w, http.StatusServiceUnavailable, map[string]string{
+			"status": health.StatusDown,
+			"reason": "shutting_down",
+		})
+		return
+	}
+
+	report := h.healthService.Check(r.Context())
+
+	status := http.StatusOK
+	if !report.Healthy() {
+		status = http.StatusServiceUnavailable
+	}
+	response.JSON(w, status, report)
+}
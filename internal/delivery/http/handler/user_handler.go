@@ -2,45 +2,74 @@ package handler
 
 import (
 	"encoding/json"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/signalable/quser/internal/clientip"
+	"github.com/signalable/quser/internal/delivery/http/response"
 	"github.com/signalable/quser/internal/domain"
 	"github.com/signalable/quser/internal/usecase"
+	"github.com/signalable/quser/internal/validation"
 )
 
 type UserHandler struct {
 	userUseCase usecase.UserUseCase
+	validator   *validation.Validator
+	logger      *slog.Logger
 }
 
 // NewUserHandler User 핸들러 생성자
-func NewUserHandler(userUseCase usecase.UserUseCase) *UserHandler {
+func NewUserHandler(userUseCase usecase.UserUseCase, validator *validation.Validator, logger *slog.Logger) *UserHandler {
 	return &UserHandler{
 		userUseCase: userUseCase,
+		validator:   validator,
+		logger:      logger.With("component", "user_handler"),
 	}
 }
 
+// respondError 에러 응답 작성 (서버 오류는 원인을 로그로 남김)
+func (h *UserHandler) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	if response.Status(err) >= http.StatusInternalServerError {
+		h.logger.ErrorContext(r.Context(), "요청 처리 실패",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"error", err,
+		)
+	}
+	response.Error(w, r, err)
+}
+
+// decodeRequest 요청 본문 디코딩 및 DTO 검증 (실패 시 에러 응답 후 false 반환)
+func (h *UserHandler) decodeRequest(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		response.Error(w, r, domain.ErrInvalidRequest)
+		return false
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.respondError(w, r, err)
+		return false
+	}
+	return true
+}
+
 // Register 회원가입 핸들러
 func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var req domain.RegisterRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+	if !h.decodeRequest(w, r, &req) {
 		return
 	}
 
 	if err := h.userUseCase.Register(r.Context(), &req); err != nil {
-		switch err {
-		case domain.ErrEmailAlreadyExists:
-			http.Error(w, err.Error(), http.StatusConflict)
-		default:
-			http.Error(w, "내부 서버 오류", http.StatusInternalServerError)
-		}
+		h.respondError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]string{
+	response.JSON(w, http.StatusCreated, map[string]string{
 		"message": "회원가입이 완료되었습니다",
 	})
 }
@@ -48,24 +77,20 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 // Login 로그인 핸들러
 func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req domain.LoginRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+	if !h.decodeRequest(w, r, &req) {
 		return
 	}
 
-	resp, err := h.userUseCase.Login(r.Context(), req.Email, req.Password)
+	resp, err := h.userUseCase.Login(r.Context(), req.Email, req.Password, domain.ClientInfo{
+		IP:        clientip.FromContext(r.Context()),
+		UserAgent: r.UserAgent(),
+	})
 	if err != nil {
-		switch err {
-		case domain.ErrInvalidCredentials:
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-		default:
-			http.Error(w, "내부 서버 오류", http.StatusInternalServerError)
-		}
+		h.respondError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(resp)
+	response.JSON(w, http.StatusOK, resp)
 }
 
 // GetProfile 프로필 조회 핸들러
@@ -75,17 +100,11 @@ func (h *UserHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 
 	profile, err := h.userUseCase.GetProfile(r.Context(), userID)
 	if err != nil {
-		switch err {
-		case domain.ErrUserNotFound:
-			http.Error(w, err.Error(), http.StatusNotFound)
-		default:
-			http.Error(w, "내부 서버 오류", http.StatusInternalServerError)
-		}
+		h.respondError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(profile)
+	response.JSON(w, http.StatusOK, profile)
 }
 
 // UpdateProfile 프로필 업데이트 핸들러
@@ -94,25 +113,16 @@ func (h *UserHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 	userID := vars["id"]
 
 	var req domain.UpdateProfileRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+	if !h.decodeRequest(w, r, &req) {
 		return
 	}
 
 	if err := h.userUseCase.UpdateProfile(r.Context(), userID, &req); err != nil {
-		switch err {
-		case domain.ErrUserNotFound:
-			http.Error(w, err.Error(), http.StatusNotFound)
-		case domain.ErrInvalidProfileData:
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		default:
-			http.Error(w, "내부 서버 오류", http.StatusInternalServerError)
-		}
+		h.respondError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{
+	response.JSON(w, http.StatusOK, map[string]string{
 		"message": "프로필이 업데이트되었습니다",
 	})
 }
@@ -124,27 +134,259 @@ func (h *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
 	token := r.URL.Query().Get("token")
 
 	if token == "" {
-		http.Error(w, "인증 토큰이 필요합니다", http.StatusBadRequest)
+		response.Error(w, r, domain.ErrInvalidRequest)
 		return
 	}
 
 	if err := h.userUseCase.VerifyEmail(r.Context(), userID, token); err != nil {
-		switch err {
-		case domain.ErrUserNotFound:
-			http.Error(w, err.Error(), http.StatusNotFound)
-		case domain.ErrEmailVerification:
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		default:
-			http.Error(w, "내부 서버 오류", http.StatusInternalServerError)
-		}
+		h.respondError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{
+	response.JSON(w, http.StatusOK, map[string]string{
 		"message": "이메일이 인증되었습니다",
 	})
+}
+
+// ResendVerification 인증 메일 재발송 핸들러
+func (h *UserHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var req domain.ResendVerificationRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	if err := h.userUseCase.ResendVerification(r.Context(), req.Email); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	// 이메일 존재 여부와 관계없이 동일한 응답
+	response.JSON(w, http.StatusAccepted, map[string]string{
+		"message": "인증 메일이 발송되었습니다",
+	})
+}
+
+// ForgotPassword 비밀번호 재설정 메일 요청 핸들러
+func (h *UserHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ForgotPasswordRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	if err := h.userUseCase.ForgotPassword(r.Context(), req.Email); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	// 이메일 존재 여부와 관계없이 동일한 응답
+	response.JSON(w, http.StatusAccepted, map[string]string{
+		"message": "비밀번호 재설정 메일이 발송되었습니다",
+	})
+}
+
+// ResetPassword 비밀번호 재설정 핸들러
+func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ResetPasswordRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	if err := h.userUseCase.ResetPassword(r.Context(), req.Token, req.NewPassword); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "비밀번호가 재설정되었습니다",
+	})
+}
+
+// DeleteAccount 계정 삭제 핸들러
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := vars["id"]
+
+	if err := h.userUseCase.DeleteAccount(r.Context(), userID); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "계정이 삭제되었습니다",
+	})
+}
+
+// RestoreAccount 삭제된 계정 복구 핸들러 (관리자)
+func (h *UserHandler) RestoreAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := vars["id"]
+
+	if err := h.userUseCase.RestoreAccount(r.Context(), userID); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "계정이 복구되었습니다",
+	})
+}
+
+// ListLoginEvents 최근 로그인 기록 조회 핸들러
+func (h *UserHandler) ListLoginEvents(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := vars["id"]
+
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			h.respondError(w, r, domain.ErrInvalidRequest)
+			return
+		}
+		limit = n
+	}
+
+	resp, err := h.userUseCase.ListLoginEvents(r.Context(), userID, limit)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// UnlockAccount 로그인 실패 잠금 해제 핸들러 (관리자)
+func (h *UserHandler) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userID := vars["id"]
+
+	if err := h.userUseCase.UnlockAccount(r.Context(), userID); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "계정 잠금이 해제되었습니다",
+	})
+}
+
+// ListUsers 사용자 목록 조회 핸들러 (관리자)
+func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	filter, page, err := parseListQuery(r)
+	if err != nil {
+		response.Error(w, r, domain.ErrInvalidRequest)
+		return
+	}
+
+	resp, err := h.userUseCase.ListUsers(r.Context(), filter, page)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
 
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// parseListQuery 목록 조회 쿼리 파라미터 파싱
+func parseListQuery(r *http.Request) (domain.UserFilter, domain.Page, error) {
+	q := r.URL.Query()
+
+	filter := domain.UserFilter{
+		Status:      q.Get("status"),
+		EmailPrefix: q.Get("email_prefix"),
+		NamePrefix:  q.Get("name_prefix"),
+	}
+	page := domain.Page{
+		Cursor:     q.Get("cursor"),
+		Descending: q.Get("order") != "asc",
+	}
+
+	if v := q.Get("is_verified"); v != "" {
+		isVerified, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, page, err
+		}
+		filter.IsVerified = &isVerified
+	}
+	if v := q.Get("created_after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, page, err
+		}
+		filter.CreatedAfter = &t
+	}
+	if v := q.Get("created_before"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, page, err
+		}
+		filter.CreatedBefore = &t
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			return filter, page, domain.ErrInvalidRequest
+		}
+		page.Limit = limit
+	}
+
+	return filter, page, nil
+}
+
+// ListSessions 내 활성 세션 목록 조회 핸들러
+func (h *UserHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	principal, ok := domain.PrincipalFromContext(r.Context())
+	if !ok {
+		h.respondError(w, r, domain.ErrUnauthenticated)
+		return
+	}
+
+	resp, err := h.userUseCase.ListSessions(r.Context(), principal.UserID, principal.SessionID)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, resp)
+}
+
+// RevokeSession 내 세션 원격 로그아웃 핸들러
+func (h *UserHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	principal, ok := domain.PrincipalFromContext(r.Context())
+	if !ok {
+		h.respondError(w, r, domain.ErrUnauthenticated)
+		return
+	}
+
+	vars := mux.Vars(r)
+	sessionID := vars["sid"]
+
+	if err := h.userUseCase.RevokeSession(r.Context(), principal.UserID, sessionID); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "세션이 로그아웃되었습니다",
+	})
+}
+
+// RevokeAllSessions 모든 기기에서 로그아웃 핸들러
+func (h *UserHandler) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	principal, ok := domain.PrincipalFromContext(r.Context())
+	if !ok {
+		h.respondError(w, r, domain.ErrUnauthenticated)
+		return
+	}
+
+	if err := h.userUseCase.RevokeAllSessions(r.Context(), principal.UserID); err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{
+		"message": "모든 기기에서 로그아웃되었습니다",
+	})
 }
 
 // Logout 로그아웃 핸들러
@@ -152,32 +394,23 @@ func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	// "Bearer " 접두사 확인 및 제거
 	auth := r.Header.Get("Authorization")
 	if auth == "" {
-		http.Error(w, "Authorization 헤더가 필요합니다", http.StatusUnauthorized)
+		response.Error(w, r, domain.ErrUnauthenticated)
 		return
 	}
 
 	parts := strings.Split(auth, " ")
 	if len(parts) != 2 || parts[0] != "Bearer" {
-		http.Error(w, "잘못된 Authorization 형식입니다", http.StatusUnauthorized)
+		response.Error(w, r, domain.ErrUnauthenticated)
 		return
 	}
 
 	token := parts[1]
 	if err := h.userUseCase.Logout(r.Context(), token); err != nil {
-		switch err {
-		case domain.ErrInvalidToken:
-			http.Error(w, err.Error(), http.StatusUnauthorized)
-		case domain.ErrLogoutFailed:
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		default:
-			http.Error(w, "내부 서버 오류", http.StatusInternalServerError)
-		}
+		h.respondError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{
+	response.JSON(w, http.StatusOK, map[string]string{
 		"message": "로그아웃되었습니다",
 	})
 }
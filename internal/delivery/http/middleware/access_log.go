@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// accessLogFields 하위 미들웨어가 채우는 요청 단위 로그 필드
+type accessLogFields struct {
+	userID string
+}
+
+type accessLogKey struct{}
+
+// setAccessLogUserID 인증된 사용자 ID를 접근 로그에 기록
+//
+// 인증 미들웨어는 새 컨텍스트로 요청을 전달하므로 바깥 미들웨어와 공유하는 값을 직접 갱신한다.
+func setAccessLogUserID(ctx context.Context, userID string) {
+	if fields, ok := ctx.Value(accessLogKey{}).(*accessLogFields); ok {
+		fields.userID = userID
+	}
+}
+
+// AccessLog 요청 단위 접근 로그 미들웨어 (메서드, 라우트, 상태, 소요 시간, 사용자 ID)
+//
+// 요청 ID는 로거가 컨텍스트에서 읽어 추가한다. 404, 405, 프리플라이트 응답도 기록되도록
+// Tracing 안쪽에서 라우터 전체를 감싸서 사용한다.
+func AccessLog(logger *slog.Logger) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := newStatusRecorder(w)
+			fields := &accessLogFields{}
+
+			next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), accessLogKey{}, fields)))
+
+			level := slog.LevelInfo
+			if rec.status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", r.Method),
+				slog.String("route", RouteTemplate(r)),
+				slog.Int("status", rec.status),
+				slog.Duration("duration", time.Since(start)),
+				slog.String("user_id", fields.userID),
+			}
+			if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+				attrs = append(attrs, slog.String("trace_id", sc.TraceID().String()))
+			}
+
+			logger.LogAttrs(r.Context(), level, "HTTP 요청", attrs...)
+		})
+	}
+}
@@ -1,20 +1,35 @@
 package middleware
 
 import (
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/signalable/quser/internal/client"
+	"github.com/signalable/quser/internal/delivery/http/response"
+	"github.com/signalable/quser/internal/domain"
 )
 
+// SelfAlias 인증된 사용자 자신을 가리키는 사용자 ID 별칭 (/api/users/me/...)
+const SelfAlias = "me"
+
+// userIDVar 사용자 ID 경로 변수 이름
+const userIDVar = "id"
+
 type AuthMiddleware struct {
-	authClient *client.AuthClient
+	verifier TokenVerifier
+	sessions SessionTracker
+	logger   *slog.Logger
 }
 
 // NewAuthMiddleware Auth 미들웨어 생성자
-func NewAuthMiddleware(authClient *client.AuthClient) *AuthMiddleware {
+func NewAuthMiddleware(verifier TokenVerifier, sessions SessionTracker, logger *slog.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
-		authClient: authClient,
+		verifier: verifier,
+		sessions: sessions,
+		logger:   logger.With("component", "auth_middleware"),
 	}
 }
 
@@ -23,21 +38,64 @@ func (m *AuthMiddleware) Authenticate(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
-			http.Error(w, "인증이 필요합니다", http.StatusUnauthorized)
+			response.Error(w, r, domain.ErrUnauthenticated)
 			return
 		}
 
 		tokenParts := strings.Split(authHeader, " ")
 		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-			http.Error(w, "잘못된 인증 형식입니다", http.StatusUnauthorized)
+			response.Error(w, r, domain.ErrUnauthenticated)
 			return
 		}
 
-		if err := m.authClient.ValidateToken(r.Context(), tokenParts[1]); err != nil {
-			http.Error(w, "유효하지 않은 토큰입니다", http.StatusUnauthorized)
+		validation, err := m.verifier.ValidateToken(r.Context(), tokenParts[1])
+		if err != nil {
+			// Auth 서비스 장애는 토큰 문제가 아니므로 401 대신 503으로 응답 (클라이언트가 로그아웃하지 않도록)
+			if client.IsUnavailable(err) {
+				m.logger.WarnContext(r.Context(), "토큰 검증 불가", "error", err)
+				response.Error(w, r, domain.ErrAuthUnavailable)
+				return
+			}
+			response.Error(w, r, domain.ErrInvalidToken)
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		// 검증된 주체를 컨텍스트에 저장
+		principal := &domain.Principal{
+			UserID:    validation.UserID,
+			SessionID: validation.SessionID,
+			Roles:     validation.Roles,
+		}
+		if validation.ExpiresAt > 0 {
+			principal.ExpiresAt = time.Unix(validation.ExpiresAt, 0)
+		}
+
+		// 세션 마지막 활동 시각 갱신 (실패해도 요청은 처리)
+		if principal.SessionID != "" {
+			if err := m.sessions.TouchSession(r.Context(), principal.SessionID); err != nil {
+				m.logger.WarnContext(r.Context(), "세션 활동 기록 실패", "user_id", principal.UserID, "error", err)
+			}
+		}
+
+		setAccessLogUserID(r.Context(), principal.UserID)
+		r = resolveSelf(r, principal.UserID)
+		next.ServeHTTP(w, r.WithContext(domain.ContextWithPrincipal(r.Context(), principal)))
+	}
+}
+
+// resolveSelf 경로의 사용자 ID가 "me"이면 인증된 사용자 ID로 치환
+//
+// 인가 정책과 핸들러는 치환된 ID만 보므로 {id}를 쓰는 모든 라우트에서 별칭을 사용할 수 있다.
+func resolveSelf(r *http.Request, userID string) *http.Request {
+	vars := mux.Vars(r)
+	if vars[userIDVar] != SelfAlias {
+		return r
+	}
+
+	resolved := make(map[string]string, len(vars))
+	for k, v := range vars {
+		resolved[k] = v
 	}
+	resolved[userIDVar] = userID
+	return mux.SetURLVars(r, resolved)
 }
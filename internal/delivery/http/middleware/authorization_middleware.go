@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/signalable/quser/internal/delivery/http/response"
+	"github.com/signalable/quser/internal/domain"
+)
+
+// Policy 인증된 주체의 요청 허용 여부를 판단하는 정책
+type Policy func(principal *domain.Principal, r *http.Request) bool
+
+// OwnerOrRoles 경로 변수의 사용자 ID가 본인이거나 주어진 역할을 가진 경우 허용
+func OwnerOrRoles(param string, roles ...string) Policy {
+	return func(principal *domain.Principal, r *http.Request) bool {
+		if mux.Vars(r)[param] == principal.UserID {
+			return true
+		}
+		return hasAnyRole(principal, roles)
+	}
+}
+
+// OwnerOrAdmin 본인 또는 관리자만 허용
+func OwnerOrAdmin(param string) Policy {
+	return OwnerOrRoles(param, domain.RoleAdmin)
+}
+
+// RequireRoles 주어진 역할 중 하나를 가진 경우에만 허용
+func RequireRoles(roles ...string) Policy {
+	return func(principal *domain.Principal, r *http.Request) bool {
+		return hasAnyRole(principal, roles)
+	}
+}
+
+// Authorize 인가 미들웨어 (Authenticate 이후에 적용)
+func (m *AuthMiddleware) Authorize(policy Policy, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := domain.PrincipalFromContext(r.Context())
+		if !ok {
+			response.Error(w, r, domain.ErrUnauthenticated)
+			return
+		}
+
+		if !policy(principal, r) {
+			response.Error(w, r, domain.ErrForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+func hasAnyRole(principal *domain.Principal, roles []string) bool {
+	for _, role := range roles {
+		if principal.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/signalable/quser/internal/clientip"
+)
+
+// ClientIP 클라이언트 IP를 결정하여 컨텍스트에 저장하는 미들웨어
+func ClientIP(trustedProxyHops int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := clientip.FromRequest(r, trustedProxyHops)
+			next.ServeHTTP(w, r.WithContext(clientip.NewContext(r.Context(), ip)))
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/signalable/quser/internal/config"
+)
+
+// CORSPolicy 교차 출처 요청 허용 정책
+type CORSPolicy struct {
+	// 허용 출처 ("https://*.example.com"은 서브도메인만, "*"는 모든 출처 허용)
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// NewCORSPolicy 설정 기반 CORS 정책 생성
+func NewCORSPolicy(cfg config.CORSConfig) CORSPolicy {
+	return CORSPolicy{
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowedMethods:   cfg.AllowedMethods,
+		AllowedHeaders:   cfg.AllowedHeaders,
+		ExposedHeaders:   cfg.ExposedHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           cfg.MaxAge,
+	}
+}
+
+// ErrCORSWildcardCredentials 모든 출처 허용과 인증 정보 허용을 함께 설정함
+//
+// 브라우저는 "*"와 인증 정보를 함께 허용하지 않는데, 요청 출처를 그대로 돌려주면 이 보호를 우회하여
+// 임의의 사이트가 사용자 인증 정보로 API를 호출할 수 있게 된다.
+var ErrCORSWildcardCredentials = errors.New("CORS 허용 출처 \"*\"와 인증 정보 허용은 함께 사용할 수 없습니다")
+
+// corsRules 요청마다 비교하기 쉽도록 정리한 정책
+type corsRules struct {
+	allowAll  bool
+	origins   map[string]bool
+	wildcards [][2]string // {스킴://, .도메인}
+	methods   map[string]bool
+	headers   map[string]bool
+
+	allowMethods     string
+	exposeHeaders    string
+	allowCredentials bool
+	maxAge           string
+}
+
+func compileCORSPolicy(p CORSPolicy) (*corsRules, error) {
+	rules := &corsRules{
+		origins:          make(map[string]bool),
+		methods:          make(map[string]bool),
+		headers:          make(map[string]bool),
+		allowMethods:     strings.ToUpper(strings.Join(p.AllowedMethods, ", ")),
+		exposeHeaders:    strings.Join(p.ExposedHeaders, ", "),
+		allowCredentials: p.AllowCredentials,
+	}
+	if p.MaxAge > 0 {
+		rules.maxAge = strconv.Itoa(int(p.MaxAge.Seconds()))
+	}
+
+	for _, origin := range p.AllowedOrigins {
+		origin = strings.ToLower(origin)
+		switch {
+		case origin == "*":
+			if p.AllowCredentials {
+				return nil, ErrCORSWildcardCredentials
+			}
+			rules.allowAll = true
+		case strings.Contains(origin, "://*."):
+			scheme, domain, _ := strings.Cut(origin, "://*")
+			rules.wildcards = append(rules.wildcards, [2]string{scheme + "://", domain})
+		default:
+			rules.origins[origin] = true
+		}
+	}
+	for _, method := range p.AllowedMethods {
+		rules.methods[strings.ToUpper(method)] = true
+	}
+	for _, header := range p.AllowedHeaders {
+		rules.headers[strings.ToLower(header)] = true
+	}
+	return rules, nil
+}
+
+func (c *corsRules) allowOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	if c.allowAll || c.origins[origin] {
+		return true
+	}
+	for _, w := range c.wildcards {
+		if len(origin) > len(w[0])+len(w[1]) && strings.HasPrefix(origin, w[0]) && strings.HasSuffix(origin, w[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowHeaders 프리플라이트가 요청한 헤더가 모두 허용되는지 확인
+func (c *corsRules) allowHeaders(requested string) bool {
+	for _, header := range strings.Split(requested, ",") {
+		header = strings.ToLower(strings.TrimSpace(header))
+		if header != "" && !c.headers[header] {
+			return false
+		}
+	}
+	return true
+}
+
+// originValue Access-Control-Allow-Origin 값
+func (c *corsRules) originValue(origin string) string {
+	if c.allowAll {
+		return "*"
+	}
+	return origin
+}
+
+// CORS 라우트별 재정의를 지원하는 CORS 미들웨어
+//
+// 프리플라이트는 요청된 메서드로 라우트를 매칭하여 존재하는 라우트에만 204로 응답하고,
+// 그 외에는 라우터에 넘겨 404/405 응답을 받는다. 라우트 매칭 전에 실행되어야 하므로
+// router.Use가 아니라 라우터 전체를 감싸서 사용한다.
+type CORS struct {
+	router    *mux.Router
+	policy    *corsRules
+	overrides map[string]*corsRules
+}
+
+// NewCORS CORS 미들웨어 생성자 (안전하지 않은 정책이면 에러)
+func NewCORS(router *mux.Router, policy CORSPolicy) (*CORS, error) {
+	rules, err := compileCORSPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	return &CORS{
+		router:    router,
+		policy:    rules,
+		overrides: make(map[string]*corsRules),
+	}, nil
+}
+
+// Override 경로 템플릿 단위로 정책 재정의 (예: "/metrics"에 빈 정책을 지정하면 교차 출처 요청 차단)
+func (c *CORS) Override(pathTemplate string, policy CORSPolicy) error {
+	rules, err := compileCORSPolicy(policy)
+	if err != nil {
+		return err
+	}
+	c.overrides[pathTemplate] = rules
+	return nil
+}
+
+// Handler CORS 헤더를 적용하는 핸들러 반환
+func (c *CORS) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		requestMethod := r.Header.Get("Access-Control-Request-Method")
+		if r.Method == http.MethodOptions && requestMethod != "" {
+			c.preflight(w, r, next, origin, requestMethod)
+			return
+		}
+
+		rules := c.rulesFor(r, r.Method)
+		w.Header().Add("Vary", "Origin")
+		if rules.allowOrigin(origin) {
+			w.Header().Set("Access-Control-Allow-Origin", rules.originValue(origin))
+			if rules.allowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+			if rules.exposeHeaders != "" {
+				w.Header().Set("Access-Control-Expose-Headers", rules.exposeHeaders)
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *CORS) preflight(w http.ResponseWriter, r *http.Request, next http.Handler, origin, requestMethod string) {
+	tmpl, ok := matchRoute(c.router, r, requestMethod)
+	if !ok {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	rules := c.policy
+	if override, ok := c.overrides[tmpl]; ok {
+		rules = override
+	}
+
+	requestHeaders := r.Header.Get("Access-Control-Request-Headers")
+	h := w.Header()
+	h.Add("Vary", "Origin")
+	h.Add("Vary", "Access-Control-Request-Method")
+	h.Add("Vary", "Access-Control-Request-Headers")
+
+	// 허용되지 않은 요청은 CORS 헤더 없이 응답하여 브라우저가 차단하도록 함
+	if rules.allowOrigin(origin) && rules.methods[strings.ToUpper(requestMethod)] && rules.allowHeaders(requestHeaders) {
+		h.Set("Access-Control-Allow-Origin", rules.originValue(origin))
+		h.Set("Access-Control-Allow-Methods", rules.allowMethods)
+		if requestHeaders != "" {
+			h.Set("Access-Control-Allow-Headers", requestHeaders)
+		}
+		if rules.allowCredentials {
+			h.Set("Access-Control-Allow-Credentials", "true")
+		}
+		if rules.maxAge != "" {
+			h.Set("Access-Control-Max-Age", rules.maxAge)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// rulesFor 요청이 매칭되는 라우트의 정책 (재정의가 없으면 기본 정책)
+func (c *CORS) rulesFor(r *http.Request, method string) *corsRules {
+	if tmpl, ok := matchRoute(c.router, r, method); ok {
+		if override, ok := c.overrides[tmpl]; ok {
+			return override
+		}
+	}
+	return c.policy
+}
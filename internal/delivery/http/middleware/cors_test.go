@@ -0,0 +1,222 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/signalable/quser/internal/delivery/http/response"
+)
+
+func TestCORSAllowOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		want    bool
+	}{
+		{"exact match", []string{"https://app.example.com"}, "https://app.example.com", true},
+		{"exact match is case-insensitive", []string{"https://App.Example.com"}, "https://app.EXAMPLE.com", true},
+		{"different host", []string{"https://app.example.com"}, "https://evil.example.com", false},
+		{"different scheme", []string{"https://app.example.com"}, "http://app.example.com", false},
+		{"different port", []string{"https://app.example.com"}, "https://app.example.com:8443", false},
+		{"wildcard subdomain", []string{"https://*.example.com"}, "https://app.example.com", true},
+		{"wildcard nested subdomain", []string{"https://*.example.com"}, "https://a.b.example.com", true},
+		{"wildcard excludes apex", []string{"https://*.example.com"}, "https://example.com", false},
+		{"wildcard excludes empty label", []string{"https://*.example.com"}, "https://.example.com", false},
+		{"wildcard excludes suffix lookalike", []string{"https://*.example.com"}, "https://evilexample.com", false},
+		{"wildcard checks scheme", []string{"https://*.example.com"}, "http://app.example.com", false},
+		{"all origins", []string{"*"}, "https://anything.test", true},
+		{"no origins", nil, "https://app.example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := compileCORSPolicy(CORSPolicy{AllowedOrigins: tt.origins})
+			if err != nil {
+				t.Fatalf("compileCORSPolicy() error = %v", err)
+			}
+			if got := rules.allowOrigin(tt.origin); got != tt.want {
+				t.Errorf("allowOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCORSRejectsWildcardWithCredentials(t *testing.T) {
+	router := mux.NewRouter()
+	policy := CORSPolicy{AllowedOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true}
+
+	if _, err := NewCORS(router, policy); !errors.Is(err, ErrCORSWildcardCredentials) {
+		t.Fatalf("NewCORS() error = %v, want %v", err, ErrCORSWildcardCredentials)
+	}
+
+	cors, err := NewCORS(router, CORSPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cors.Override("/metrics", policy); !errors.Is(err, ErrCORSWildcardCredentials) {
+		t.Fatalf("Override() error = %v, want %v", err, ErrCORSWildcardCredentials)
+	}
+}
+
+func TestCORSHandler(t *testing.T) {
+	router := mux.NewRouter()
+	router.NotFoundHandler = response.NotFoundHandler()
+	router.MethodNotAllowedHandler = response.MethodNotAllowedHandler()
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	router.HandleFunc("/api/users/{id}", ok).Methods("GET", "PUT")
+	router.HandleFunc("/metrics", ok).Methods("GET")
+
+	cors, err := NewCORS(router, CORSPolicy{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowedMethods:   []string{"GET", "PUT"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		ExposedHeaders:   []string{"X-Request-ID"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cors.Override("/metrics", CORSPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	handler := cors.Handler(router)
+
+	const allowed = "https://app.example.com"
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		origin         string
+		requestMethod  string // Access-Control-Request-Method (프리플라이트)
+		requestHeaders string
+		wantStatus     int
+		wantHeaders    map[string]string // 빈 값은 헤더가 없어야 함
+	}{
+		{
+			name: "preflight for existing route", method: http.MethodOptions, path: "/api/users/1",
+			origin: allowed, requestMethod: "PUT", requestHeaders: "Authorization, Content-Type",
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      allowed,
+				"Access-Control-Allow-Methods":     "GET, PUT",
+				"Access-Control-Allow-Headers":     "Authorization, Content-Type",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Max-Age":           "600",
+			},
+		},
+		{
+			name: "preflight from disallowed origin", method: http.MethodOptions, path: "/api/users/1",
+			origin: "https://evil.test", requestMethod: "GET",
+			wantStatus:  http.StatusNoContent,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Access-Control-Allow-Methods": ""},
+		},
+		{
+			name: "preflight with disallowed header", method: http.MethodOptions, path: "/api/users/1",
+			origin: allowed, requestMethod: "GET", requestHeaders: "X-Custom",
+			wantStatus:  http.StatusNoContent,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "preflight for method without route", method: http.MethodOptions, path: "/api/users/1",
+			origin: allowed, requestMethod: "DELETE",
+			wantStatus:  http.StatusMethodNotAllowed,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "preflight for unknown path", method: http.MethodOptions, path: "/unknown",
+			origin: allowed, requestMethod: "GET",
+			wantStatus:  http.StatusNotFound,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "preflight for overridden route", method: http.MethodOptions, path: "/metrics",
+			origin: allowed, requestMethod: "GET",
+			wantStatus:  http.StatusNoContent,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "simple request from allowed origin", method: http.MethodGet, path: "/api/users/1",
+			origin:     allowed,
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      allowed,
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Expose-Headers":    "X-Request-ID",
+				"Vary":                             "Origin",
+			},
+		},
+		{
+			name: "simple request from disallowed origin", method: http.MethodGet, path: "/api/users/1",
+			origin:      "https://evil.test",
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Access-Control-Allow-Credentials": ""},
+		},
+		{
+			name: "simple request to overridden route", method: http.MethodGet, path: "/metrics",
+			origin:      allowed,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "request without origin", method: http.MethodGet, path: "/api/users/1",
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Vary": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.requestMethod != "" {
+				req.Header.Set("Access-Control-Request-Method", tt.requestMethod)
+			}
+			if tt.requestHeaders != "" {
+				req.Header.Set("Access-Control-Request-Headers", tt.requestHeaders)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			for header, want := range tt.wantHeaders {
+				if got := rec.Header().Get(header); got != want {
+					t.Errorf("%s = %q, want %q", header, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCORSAllOriginsWithoutCredentials(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {}).Methods("GET")
+
+	cors, err := NewCORS(router, CORSPolicy{AllowedOrigins: []string{"*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://anything.test")
+	rec := httptest.NewRecorder()
+	cors.Handler(router).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, "*")
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+		t.Errorf("Access-Control-Allow-Credentials = %q, want none", got)
+	}
+}
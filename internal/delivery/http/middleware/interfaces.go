@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/signalable/quser/internal/client"
+)
+
+// TokenVerifier 액세스 토큰 검증 (Auth 서비스 원격 검증 또는 JWKS 기반 로컬 검증)
+type TokenVerifier interface {
+	ValidateToken(ctx context.Context, token string) (*client.TokenValidationResponse, error)
+}
+
+// SessionTracker 인증된 요청의 세션 활동 기록
+type SessionTracker interface {
+	TouchSession(ctx context.Context, sessionID string) error
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/signalable/quser/internal/metrics"
+)
+
+// Metrics 라우트 템플릿 기준 HTTP 요청 지표 수집 미들웨어
+//
+// 404, 405, 프리플라이트 응답도 기록되도록 Route 미들웨어 안쪽에서 라우터 전체를 감싸서 사용한다.
+// 매칭된 라우트가 없는 요청은 unmatched, 표준이 아닌 메서드는 other 라벨로 모아 카디널리티가 늘지 않게 한다.
+func Metrics(m *metrics.Metrics) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := newStatusRecorder(w)
+
+			next.ServeHTTP(rec, r)
+
+			m.ObserveHTTPRequest(RouteTemplate(r), methodLabel(r.Method), strconv.Itoa(rec.status), time.Since(start).Seconds())
+		})
+	}
+}
+
+// methodLabel 지표 라벨용 메서드 (클라이언트가 임의의 메서드로 시계열을 늘리지 못하도록 표준 메서드만 그대로 사용)
+func methodLabel(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return method
+	}
+	return "other"
+}
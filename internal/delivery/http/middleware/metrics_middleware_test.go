@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestMethodLabel(t *testing.T) {
+	tests := map[string]string{
+		http.MethodGet:     http.MethodGet,
+		http.MethodOptions: http.MethodOptions,
+		"get":              "other",
+		"PROPFIND":         "other",
+		"":                 "other",
+	}
+
+	for method, want := range tests {
+		if got := methodLabel(method); got != want {
+			t.Errorf("methodLabel(%q) = %q, want %q", method, got, want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/signalable/quser/internal/clientip"
+	"github.com/signalable/quser/internal/delivery/http/response"
+	"github.com/signalable/quser/internal/domain"
+	"github.com/signalable/quser/internal/ratelimit"
+	"github.com/signalable/quser/internal/security"
+)
+
+// maxPeekBodySize 이메일 추출을 위해 미리 읽는 본문 크기 상한
+const maxPeekBodySize = 64 << 10
+
+// 요청 제한 scope
+const (
+	RateLimitScopeLogin          = "login"
+	RateLimitScopeRegister       = "register"
+	RateLimitScopeVerifyEmail    = "verify_email"
+	RateLimitScopeResendVerify   = "resend_verification"
+	RateLimitScopeForgotPassword = "forgot_password"
+	RateLimitScopeResetPassword  = "reset_password"
+)
+
+// RateLimitRules 라우트별 요청 제한 (값이 비어 있는 제한은 적용하지 않음)
+type RateLimitRules struct {
+	PerIP      ratelimit.Limit
+	PerEmail   ratelimit.Limit
+	PerIPEmail ratelimit.Limit
+}
+
+// RateLimiter 클라이언트 IP와 대상 이메일 기준 요청 제한 미들웨어
+type RateLimiter struct {
+	limiter   *ratelimit.Limiter
+	rules     map[string]RateLimitRules
+	normalize func(email string) (string, error)
+	logger    *slog.Logger
+}
+
+// NewRateLimiter 요청 제한 미들웨어 생성자 (rules는 scope별 제한)
+//
+// normalize는 대소문자나 점 표기를 바꿔 이메일 제한을 우회하지 못하도록 키를 정규화한다.
+func NewRateLimiter(
+	limiter *ratelimit.Limiter,
+	rules map[string]RateLimitRules,
+	normalize func(email string) (string, error),
+	logger *slog.Logger,
+) *RateLimiter {
+	return &RateLimiter{
+		limiter:   limiter,
+		rules:     rules,
+		normalize: normalize,
+		logger:    logger.With("component", "rate_limiter"),
+	}
+}
+
+// Limit scope의 제한을 적용하는 미들웨어 (초과 시 429와 Retry-After 응답)
+func (l *RateLimiter) Limit(scope string, next http.HandlerFunc) http.HandlerFunc {
+	rules, ok := l.rules[scope]
+	if !ok {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ip := clientip.FromContext(r.Context())
+		email := l.peekEmail(r)
+
+		keys := []ratelimit.Key{{Name: scope + ":ip:" + ip, Limit: rules.PerIP}}
+		if email != "" {
+			// 저장소에 이메일이 남지 않도록 해시를 키로 사용
+			emailKey := security.HashToken(email)
+			keys = append(keys,
+				ratelimit.Key{Name: scope + ":email:" + emailKey, Limit: rules.PerEmail},
+				ratelimit.Key{Name: scope + ":ip_email:" + ip + ":" + emailKey, Limit: rules.PerIPEmail},
+			)
+		}
+
+		result, err := l.limiter.Allow(r.Context(), keys...)
+		if err != nil {
+			// 저장소 장애로 로그인 등 공개 엔드포인트 전체가 막히지 않도록 허용
+			l.logger.WarnContext(r.Context(), "요청 제한 확인 실패", "scope", scope, "error", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !result.Allowed {
+			l.logger.InfoContext(r.Context(), "요청 제한 초과", "scope", scope, "ip", ip, "retry_after", result.RetryAfter)
+			response.Error(w, r, &domain.RetryAfterError{
+				Err:        domain.ErrTooManyRequests,
+				RetryAfter: result.RetryAfter,
+			})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// peekEmail 본문의 email 필드를 정규화하여 반환 (핸들러가 다시 읽을 수 있도록 본문 복원)
+func (l *RateLimiter) peekEmail(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxPeekBodySize))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), r.Body), r.Body}
+	if err != nil {
+		return ""
+	}
+
+	var body struct {
+		Email string `json:"email"`
+	}
+	if json.Unmarshal(buf, &body) != nil || body.Email == "" {
+		return ""
+	}
+
+	canonical, err := l.normalize(body.Email)
+	if err != nil {
+		return ""
+	}
+	return canonical
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/signalable/quser/internal/requestid"
+)
+
+// RequestID 요청 ID 전파 미들웨어
+//
+// 클라이언트가 보낸 X-Request-ID를 사용하거나 새로 생성하여 컨텍스트와 응답 헤더에 기록한다.
+// 라우트 매칭 실패 응답에도 포함되도록 라우터 전체를 감싸서 사용한다.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.Generate()
+		}
+
+		w.Header().Set(requestid.Header, id)
+		next.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), id)))
+	})
+}
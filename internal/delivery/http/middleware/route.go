@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// unmatchedRoute 매칭되는 라우트가 없는 요청(404, 405 등)의 라우트 라벨
+const unmatchedRoute = "unmatched"
+
+type routeKey struct{}
+
+// Route 라우트 매칭 전에 요청의 경로 템플릿을 찾아 컨텍스트에 기록하는 미들웨어
+//
+// 라우터 전체를 감싸는 지표·접근 로그·트레이싱이 매칭 실패 응답과 프리플라이트 요청까지
+// 경로 변수 대신 템플릿으로 기록할 수 있게 한다. 프리플라이트는 실제 요청할 메서드로 매칭한다.
+func Route(router *mux.Router) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method := r.Method
+			if requestMethod := r.Header.Get("Access-Control-Request-Method"); method == http.MethodOptions && requestMethod != "" {
+				method = requestMethod
+			}
+
+			tmpl, ok := matchRoute(router, r, method)
+			if !ok {
+				tmpl = unmatchedRoute
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), routeKey{}, tmpl)))
+		})
+	}
+}
+
+// RouteTemplate Route 미들웨어가 기록한 경로 템플릿 (매칭된 라우트가 없으면 unmatched)
+func RouteTemplate(r *http.Request) string {
+	if tmpl, ok := r.Context().Value(routeKey{}).(string); ok {
+		return tmpl
+	}
+	return unmatchedRoute
+}
+
+// matchRoute 지정한 메서드로 요청했을 때 매칭되는 라우트의 경로 템플릿
+func matchRoute(router *mux.Router, r *http.Request, method string) (string, bool) {
+	probe := r.WithContext(r.Context())
+	probe.Method = method
+
+	var match mux.RouteMatch
+	if !router.Match(probe, &match) || match.MatchErr != nil || match.Route == nil {
+		return "", false
+	}
+
+	tmpl, err := match.Route.GetPathTemplate()
+	if err != nil {
+		return "", false
+	}
+	return tmpl, true
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/users/{id}", func(w http.ResponseWriter, r *http.Request) {}).Methods("GET")
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		requestMethod string
+		want          string
+	}{
+		{"matched route", http.MethodGet, "/api/users/1", "", "/api/users/{id}"},
+		{"unknown path", http.MethodGet, "/unknown", "", unmatchedRoute},
+		{"method mismatch", http.MethodDelete, "/api/users/1", "", unmatchedRoute},
+		{"preflight for allowed method", http.MethodOptions, "/api/users/1", "GET", "/api/users/{id}"},
+		{"preflight for method without route", http.MethodOptions, "/api/users/1", "PUT", unmatchedRoute},
+		{"options without preflight header", http.MethodOptions, "/api/users/1", "", unmatchedRoute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.requestMethod != "" {
+				req.Header.Set("Access-Control-Request-Method", tt.requestMethod)
+			}
+
+			var got string
+			Route(router)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = RouteTemplate(r)
+			})).ServeHTTP(httptest.NewRecorder(), req)
+
+			if got != tt.want {
+				t.Errorf("RouteTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
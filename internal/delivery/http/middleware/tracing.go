@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Tracing 요청 단위 서버 스팬 생성 미들웨어 (스팬 이름은 메서드와 라우트 템플릿)
+//
+// 매칭 실패 응답도 추적되고 접근 로그가 trace_id를 기록할 수 있도록 Route와 AccessLog 사이에서 라우터 전체를 감싸서 사용한다.
+func Tracing(serviceName string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return otelhttp.NewHandler(next, serviceName,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return r.Method + " " + RouteTemplate(r)
+			}),
+		)
+	}
+}
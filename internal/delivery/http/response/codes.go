@@ -0,0 +1,27 @@
+package response
+
+// 에러 코드 (클라이언트 분기용으로 변경하지 않음)
+const (
+	CodeInvalidRequest          = "INVALID_REQUEST"
+	CodeValidationFailed        = "VALIDATION_FAILED"
+	CodeNotFound                = "NOT_FOUND"
+	CodeMethodNotAllowed        = "METHOD_NOT_ALLOWED"
+	CodeUserNotFound            = "USER_NOT_FOUND"
+	CodeEmailAlreadyExists      = "EMAIL_ALREADY_EXISTS"
+	CodeInvalidEmail            = "INVALID_EMAIL"
+	CodeInvalidCredentials      = "INVALID_CREDENTIALS"
+	CodeInvalidCursor           = "INVALID_CURSOR"
+	CodeRestoreNotAllowed       = "RESTORE_NOT_ALLOWED"
+	CodeAccountLocked           = "ACCOUNT_LOCKED"
+	CodeInvalidProfileData      = "INVALID_PROFILE_DATA"
+	CodeEmailVerificationFailed = "EMAIL_VERIFICATION_FAILED"
+	CodeTooManyRequests         = "TOO_MANY_REQUESTS"
+	CodeUnauthenticated         = "UNAUTHENTICATED"
+	CodeLogoutFailed            = "LOGOUT_FAILED"
+	CodeInvalidToken            = "INVALID_TOKEN"
+	CodeInvalidResetToken       = "INVALID_RESET_TOKEN"
+	CodeAuthUnavailable         = "AUTH_UNAVAILABLE"
+	CodeSessionNotFound         = "SESSION_NOT_FOUND"
+	CodeForbidden               = "FORBIDDEN"
+	CodeInternal                = "INTERNAL_ERROR"
+)
@@ -0,0 +1,104 @@
+package response
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/signalable/quser/internal/domain"
+	"github.com/signalable/quser/internal/requestid"
+)
+
+// ErrorResponse 에러 응답 모델
+type ErrorResponse struct {
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	Details   interface{} `json:"details,omitempty"`
+	RequestID string      `json:"request_id,omitempty"`
+}
+
+// errorMapping 도메인 에러와 HTTP 상태 및 에러 코드 매핑
+type errorMapping struct {
+	err    error
+	status int
+	code   string
+}
+
+var errorMappings = []errorMapping{
+	{domain.ErrInvalidRequest, http.StatusBadRequest, CodeInvalidRequest},
+	{domain.ErrNotFound, http.StatusNotFound, CodeNotFound},
+	{domain.ErrMethodNotAllowed, http.StatusMethodNotAllowed, CodeMethodNotAllowed},
+	{domain.ErrUserNotFound, http.StatusNotFound, CodeUserNotFound},
+	{domain.ErrEmailAlreadyExists, http.StatusConflict, CodeEmailAlreadyExists},
+	{domain.ErrInvalidEmail, http.StatusBadRequest, CodeInvalidEmail},
+	{domain.ErrInvalidCredentials, http.StatusUnauthorized, CodeInvalidCredentials},
+	{domain.ErrInvalidCursor, http.StatusBadRequest, CodeInvalidCursor},
+	{domain.ErrRestoreNotAllowed, http.StatusNotFound, CodeRestoreNotAllowed},
+	{domain.ErrAccountLocked, http.StatusTooManyRequests, CodeAccountLocked},
+	{domain.ErrInvalidProfileData, http.StatusBadRequest, CodeInvalidProfileData},
+	{domain.ErrEmailVerification, http.StatusBadRequest, CodeEmailVerificationFailed},
+	{domain.ErrTooManyRequests, http.StatusTooManyRequests, CodeTooManyRequests},
+	{domain.ErrUnauthenticated, http.StatusUnauthorized, CodeUnauthenticated},
+	{domain.ErrLogoutFailed, http.StatusInternalServerError, CodeLogoutFailed},
+	{domain.ErrInvalidToken, http.StatusUnauthorized, CodeInvalidToken},
+	{domain.ErrInvalidResetToken, http.StatusBadRequest, CodeInvalidResetToken},
+	{domain.ErrAuthUnavailable, http.StatusServiceUnavailable, CodeAuthUnavailable},
+	{domain.ErrSessionNotFound, http.StatusNotFound, CodeSessionNotFound},
+	{domain.ErrForbidden, http.StatusForbidden, CodeForbidden},
+}
+
+// Error 에러를 코드와 상태로 매핑하여 JSON 에러 응답 작성
+//
+// 메시지는 Accept-Language 헤더에 따라 지역화된다.
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	lang := negotiateLanguage(r)
+	status, code, details := resolve(err, lang)
+
+	var retryErr *domain.RetryAfterError
+	if errors.As(err, &retryErr) && retryErr.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryErr.RetryAfter.Seconds()))))
+	}
+
+	JSON(w, status, &ErrorResponse{
+		Code:      code,
+		Message:   localizedMessage(code, lang),
+		Details:   details,
+		RequestID: requestid.FromContext(r.Context()),
+	})
+}
+
+// Status 에러에 대응하는 HTTP 상태 코드
+func Status(err error) int {
+	status, _, _ := resolve(err, defaultLanguage)
+	return status
+}
+
+// NotFoundHandler 매칭되는 라우트가 없을 때의 JSON 응답 핸들러
+func NotFoundHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Error(w, r, domain.ErrNotFound)
+	})
+}
+
+// MethodNotAllowedHandler 허용되지 않은 메서드일 때의 JSON 응답 핸들러
+func MethodNotAllowedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Error(w, r, domain.ErrMethodNotAllowed)
+	})
+}
+
+func resolve(err error, lang string) (int, string, interface{}) {
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return http.StatusUnprocessableEntity, CodeValidationFailed, localizedFieldErrors(validationErr.Fields, lang)
+	}
+
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return m.status, m.code, nil
+		}
+	}
+
+	return http.StatusInternalServerError, CodeInternal, nil
+}
@@ -0,0 +1,210 @@
+package response
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/signalable/quser/internal/domain"
+)
+
+// 지원 언어
+const (
+	langKorean  = "ko"
+	langEnglish = "en"
+
+	defaultLanguage = langKorean
+)
+
+// messages 에러 코드별 지역화 메시지
+var messages = map[string]map[string]string{
+	CodeInvalidRequest: {
+		langKorean:  "잘못된 요청 형식입니다",
+		langEnglish: "The request is malformed",
+	},
+	CodeValidationFailed: {
+		langKorean:  "요청 검증에 실패했습니다",
+		langEnglish: "The request failed validation",
+	},
+	CodeNotFound: {
+		langKorean:  "요청한 리소스를 찾을 수 없습니다",
+		langEnglish: "The requested resource was not found",
+	},
+	CodeMethodNotAllowed: {
+		langKorean:  "허용되지 않은 메서드입니다",
+		langEnglish: "The method is not allowed",
+	},
+	CodeUserNotFound: {
+		langKorean:  "사용자를 찾을 수 없습니다",
+		langEnglish: "User not found",
+	},
+	CodeEmailAlreadyExists: {
+		langKorean:  "이미 존재하는 이메일입니다",
+		langEnglish: "The email is already registered",
+	},
+	CodeInvalidEmail: {
+		langKorean:  "잘못된 이메일 형식입니다",
+		langEnglish: "The email address is invalid",
+	},
+	CodeInvalidCredentials: {
+		langKorean:  "잘못된 인증 정보입니다",
+		langEnglish: "Invalid email or password",
+	},
+	CodeInvalidCursor: {
+		langKorean:  "잘못된 페이지 커서입니다",
+		langEnglish: "The page cursor is invalid",
+	},
+	CodeRestoreNotAllowed: {
+		langKorean:  "복구할 수 있는 계정이 없습니다",
+		langEnglish: "There is no account that can be restored",
+	},
+	CodeAccountLocked: {
+		langKorean:  "로그인 실패가 반복되어 계정이 잠겼습니다. 잠시 후 다시 시도해주세요",
+		langEnglish: "The account is locked after repeated failed logins. Please try again later",
+	},
+	CodeInvalidProfileData: {
+		langKorean:  "잘못된 프로필 데이터입니다",
+		langEnglish: "The profile data is invalid",
+	},
+	CodeEmailVerificationFailed: {
+		langKorean:  "이메일 검증에 실패했습니다",
+		langEnglish: "Email verification failed",
+	},
+	CodeTooManyRequests: {
+		langKorean:  "요청이 너무 많습니다. 잠시 후 다시 시도해주세요",
+		langEnglish: "Too many requests. Please try again later",
+	},
+	CodeUnauthenticated: {
+		langKorean:  "인증이 필요합니다",
+		langEnglish: "Authentication is required",
+	},
+	CodeLogoutFailed: {
+		langKorean:  "로그아웃 처리에 실패했습니다",
+		langEnglish: "Logout failed",
+	},
+	CodeInvalidToken: {
+		langKorean:  "유효하지 않은 토큰입니다",
+		langEnglish: "The token is invalid",
+	},
+	CodeInvalidResetToken: {
+		langKorean:  "유효하지 않거나 만료된 재설정 토큰입니다",
+		langEnglish: "The reset token is invalid or has expired",
+	},
+	CodeAuthUnavailable: {
+		langKorean:  "인증 서비스를 일시적으로 사용할 수 없습니다. 잠시 후 다시 시도해주세요",
+		langEnglish: "The authentication service is temporarily unavailable. Please try again later",
+	},
+	CodeSessionNotFound: {
+		langKorean:  "세션을 찾을 수 없습니다",
+		langEnglish: "Session not found",
+	},
+	CodeForbidden: {
+		langKorean:  "접근 권한이 없습니다",
+		langEnglish: "You do not have permission to access this resource",
+	},
+	CodeInternal: {
+		langKorean:  "내부 서버 오류",
+		langEnglish: "Internal server error",
+	},
+}
+
+// fieldMessages 검증 규칙별 지역화 메시지 (%s는 규칙 파라미터)
+var fieldMessages = map[string]map[string]string{
+	"required": {
+		langKorean:  "필수 항목입니다",
+		langEnglish: "This field is required",
+	},
+	"email": {
+		langKorean:  "올바른 이메일 형식이 아닙니다",
+		langEnglish: "Must be a valid email address",
+	},
+	"min": {
+		langKorean:  "최소 %s자 이상이어야 합니다",
+		langEnglish: "Must be at least %s characters long",
+	},
+	"max": {
+		langKorean:  "최대 %s자까지 입력할 수 있습니다",
+		langEnglish: "Must be at most %s characters long",
+	},
+	"e164": {
+		langKorean:  "E.164 형식의 전화번호가 아닙니다 (예: +821012345678)",
+		langEnglish: "Must be an E.164 phone number (e.g. +821012345678)",
+	},
+	"avatar_url": {
+		langKorean:  "https URL만 사용할 수 있습니다",
+		langEnglish: "Must be an https URL",
+	},
+	"": {
+		langKorean:  "올바르지 않은 값입니다",
+		langEnglish: "The value is invalid",
+	},
+}
+
+func localizedMessage(code, lang string) string {
+	if msg, ok := messages[code][lang]; ok {
+		return msg
+	}
+	return messages[code][defaultLanguage]
+}
+
+func localizedFieldErrors(fields []domain.FieldError, lang string) []domain.FieldError {
+	localized := make([]domain.FieldError, len(fields))
+	for i, field := range fields {
+		catalog, ok := fieldMessages[field.Rule]
+		if !ok {
+			catalog = fieldMessages[""]
+		}
+		msg := catalog[lang]
+		if strings.Contains(msg, "%s") {
+			msg = fmt.Sprintf(msg, field.Param)
+		}
+
+		field.Message = msg
+		localized[i] = field
+	}
+	return localized
+}
+
+// negotiateLanguage Accept-Language 헤더에서 지원 언어 선택 (q 값 우선)
+func negotiateLanguage(r *http.Request) string {
+	header := r.Header.Get("Accept-Language")
+	if header == "" {
+		return defaultLanguage
+	}
+
+	type candidate struct {
+		lang string
+		q    float64
+	}
+	var candidates []candidate
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+
+		// 지역 코드는 무시 (en-US → en)
+		base, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		candidates = append(candidates, candidate{lang: base, q: q})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+
+	for _, c := range candidates {
+		if c.q <= 0 {
+			continue
+		}
+		if c.lang == langKorean || c.lang == langEnglish {
+			return c.lang
+		}
+	}
+	return defaultLanguage
+}
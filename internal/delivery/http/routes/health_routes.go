@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/signalable/quser/internal/delivery/http/handler"
+)
+
+// SetupHealthRoutes 상태 확인 라우터 설정
+func SetupHealthRoutes(
+	router *mux.Router,
+	healthHandler *handler.HealthHandler,
+) {
+	router.HandleFunc("/healthz", healthHandler.Liveness).Methods("GET")
+	router.HandleFunc("/readyz", healthHandler.Readiness).Methods("GET")
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/signalable/quser/internal/metrics"
+)
+
+// SetupMetricsRoutes 지표 노출 라우터 설정
+func SetupMetricsRoutes(
+	router *mux.Router,
+	m *metrics.Metrics,
+) {
+	router.Handle("/metrics", m.Handler()).Methods("GET")
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/signalable/quser/internal/delivery/http/handler"
 	"github.com/signalable/quser/internal/delivery/http/middleware"
+	"github.com/signalable/quser/internal/delivery/http/response"
+	"github.com/signalable/quser/internal/domain"
 )
 
 // SetupUserRoutes 라우터 설정
@@ -11,14 +13,52 @@ func SetupUserRoutes(
 	router *mux.Router,
 	userHandler *handler.UserHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	rateLimiter *middleware.RateLimiter,
 ) {
+	// 매칭되는 라우트가 없을 때도 JSON 에러 응답
+	router.NotFoundHandler = response.NotFoundHandler()
+	router.MethodNotAllowedHandler = response.MethodNotAllowedHandler()
+
 	// 공개 라우트
-	router.HandleFunc("/api/users/register", userHandler.Register).Methods("POST")
-	router.HandleFunc("/api/users/login", userHandler.Login).Methods("POST")
+	router.HandleFunc("/api/users/register", rateLimiter.Limit(middleware.RateLimitScopeRegister, userHandler.Register)).Methods("POST")
+	router.HandleFunc("/api/users/login", rateLimiter.Limit(middleware.RateLimitScopeLogin, userHandler.Login)).Methods("POST")
+	router.HandleFunc("/api/users/{id}/verify", rateLimiter.Limit(middleware.RateLimitScopeVerifyEmail, userHandler.VerifyEmail)).Methods("GET")
+	router.HandleFunc("/api/users/verify/resend", rateLimiter.Limit(middleware.RateLimitScopeResendVerify, userHandler.ResendVerification)).Methods("POST")
+	router.HandleFunc("/api/users/password/forgot", rateLimiter.Limit(middleware.RateLimitScopeForgotPassword, userHandler.ForgotPassword)).Methods("POST")
+	router.HandleFunc("/api/users/password/reset", rateLimiter.Limit(middleware.RateLimitScopeResetPassword, userHandler.ResetPassword)).Methods("POST")
 
-	// 인증이 필요한 라우트
+	// 인증이 필요한 라우트 ({id} 자리에 "me"를 쓰면 토큰의 사용자로 해석)
 	router.HandleFunc("/api/users/logout", userHandler.Logout).Methods("POST")
-	router.HandleFunc("/api/users/{id}/profile", authMiddleware.Authenticate(userHandler.GetProfile)).Methods("GET")
-	router.HandleFunc("/api/users/{id}/profile", authMiddleware.Authenticate(userHandler.UpdateProfile)).Methods("PUT")
+	router.HandleFunc("/api/users/me/sessions", authMiddleware.Authenticate(userHandler.ListSessions)).Methods("GET")
+	router.HandleFunc("/api/users/me/sessions", authMiddleware.Authenticate(userHandler.RevokeAllSessions)).Methods("DELETE")
+	router.HandleFunc("/api/users/me/sessions/{sid}", authMiddleware.Authenticate(userHandler.RevokeSession)).Methods("DELETE")
+	router.HandleFunc("/api/users", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.RequireRoles(domain.RoleAdmin), userHandler.ListUsers),
+	)).Methods("GET")
+	router.HandleFunc("/api/users/{id}", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.OwnerOrAdmin("id"), userHandler.GetProfile),
+	)).Methods("GET")
+	router.HandleFunc("/api/users/{id}", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.OwnerOrAdmin("id"), userHandler.UpdateProfile),
+	)).Methods("PUT")
+	router.HandleFunc("/api/users/{id}/profile", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.OwnerOrAdmin("id"), userHandler.GetProfile),
+	)).Methods("GET")
+	router.HandleFunc("/api/users/{id}/profile", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.OwnerOrAdmin("id"), userHandler.UpdateProfile),
+	)).Methods("PUT")
+	router.HandleFunc("/api/users/{id}", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.OwnerOrAdmin("id"), userHandler.DeleteAccount),
+	)).Methods("DELETE")
+	// 삭제된 계정은 로그인할 수 없으므로 복구는 관리자만 가능
+	router.HandleFunc("/api/users/{id}/restore", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.RequireRoles(domain.RoleAdmin), userHandler.RestoreAccount),
+	)).Methods("POST")
+	router.HandleFunc("/api/users/{id}/logins", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.OwnerOrAdmin("id"), userHandler.ListLoginEvents),
+	)).Methods("GET")
+	router.HandleFunc("/api/users/{id}/unlock", authMiddleware.Authenticate(
+		authMiddleware.Authorize(middleware.RequireRoles(domain.RoleAdmin), userHandler.UnlockAccount),
+	)).Methods("POST")
 
 }
@@ -14,19 +14,51 @@ type LoginResponse struct {
 	User        *UserResponse `json:"user"`
 }
 
+// LoginHistoryResponse 로그인 기록 조회 응답 DTO
+type LoginHistoryResponse struct {
+	Events []*LoginEvent `json:"events"`
+}
+
+// SessionListResponse 활성 세션 목록 응답 DTO
+type SessionListResponse struct {
+	Sessions []*Session `json:"sessions"`
+}
+
 // RegisterRequest 회원가입 요청 DTO
 type RegisterRequest struct {
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=8"`
-	Name     string `json:"name" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=128"`
+	Name     string `json:"name" validate:"required,max=100"`
+}
+
+// ResendVerificationRequest 인증 메일 재발송 요청 DTO
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ForgotPasswordRequest 비밀번호 재설정 메일 요청 DTO
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordRequest 비밀번호 재설정 요청 DTO
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8,max=128"`
 }
 
 // UpdateProfileRequest 프로필 업데이트 요청 DTO
 type UpdateProfileRequest struct {
-	Name        string `json:"name,omitempty"`
-	PhoneNumber string `json:"phone_number,omitempty"`
-	Bio         string `json:"bio,omitempty"`
-	Avatar      string `json:"avatar,omitempty"`
+	Name        string `json:"name,omitempty" validate:"omitempty,max=100"`
+	PhoneNumber string `json:"phone_number,omitempty" validate:"omitempty,e164"`
+	Bio         string `json:"bio,omitempty" validate:"omitempty,max=500"`
+	Avatar      string `json:"avatar,omitempty" validate:"omitempty,max=2048,avatar_url"`
+}
+
+// UserListResponse 사용자 목록 응답 DTO
+type UserListResponse struct {
+	Users      []*UserResponse `json:"users"`
+	NextCursor string          `json:"next_cursor,omitempty"`
 }
 
 // UserResponse 사용자 응답 DTO
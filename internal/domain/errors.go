@@ -1,13 +1,26 @@
 // quser/internal/domain/errors.go
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
+	// 요청 관련 에러
+	ErrInvalidRequest   = errors.New("잘못된 요청 형식입니다")
+	ErrNotFound         = errors.New("요청한 리소스를 찾을 수 없습니다")
+	ErrMethodNotAllowed = errors.New("허용되지 않은 메서드입니다")
+
 	// 사용자 관련 에러
 	ErrUserNotFound       = errors.New("사용자를 찾을 수 없습니다")
 	ErrEmailAlreadyExists = errors.New("이미 존재하는 이메일입니다")
+	ErrInvalidEmail       = errors.New("잘못된 이메일 형식입니다")
 	ErrInvalidCredentials = errors.New("잘못된 인증 정보입니다")
+	ErrInvalidCursor      = errors.New("잘못된 페이지 커서입니다")
+	ErrRestoreNotAllowed  = errors.New("복구할 수 있는 계정이 없습니다")
+	ErrAccountLocked      = errors.New("로그인 실패가 반복되어 계정이 잠겼습니다")
 
 	// 프로필 관련 에러
 	ErrInvalidProfileData = errors.New("잘못된 프로필 데이터입니다")
@@ -15,7 +28,53 @@ var (
 	// 검증 관련 에러
 	ErrEmailVerification = errors.New("이메일 검증에 실패했습니다")
 
+	// 요청 제한 관련 에러
+	ErrTooManyRequests = errors.New("요청이 너무 많습니다. 잠시 후 다시 시도해주세요")
+
 	// 인증 관련 에러
-	ErrLogoutFailed = errors.New("로그아웃 처리에 실패했습니다")
-	ErrInvalidToken = errors.New("잘못된 토큰입니다")
+	ErrUnauthenticated   = errors.New("인증이 필요합니다")
+	ErrLogoutFailed      = errors.New("로그아웃 처리에 실패했습니다")
+	ErrInvalidToken      = errors.New("잘못된 토큰입니다")
+	ErrInvalidResetToken = errors.New("유효하지 않거나 만료된 재설정 토큰입니다")
+	ErrAuthUnavailable   = errors.New("인증 서비스를 일시적으로 사용할 수 없습니다")
+
+	// 세션 관련 에러
+	ErrSessionNotFound = errors.New("세션을 찾을 수 없습니다")
+
+	// 권한 관련 에러
+	ErrForbidden = errors.New("접근 권한이 없습니다")
 )
+
+// RetryAfterError 일정 시간 후 재시도할 수 있는 에러 (Retry-After 헤더로 전달)
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError 필드 단위 검증 실패 정보
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ValidationError 요청 검증 실패
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return "요청 검증에 실패했습니다"
+	}
+	return fmt.Sprintf("요청 검증에 실패했습니다: %s(%s)", e.Fields[0].Field, e.Fields[0].Rule)
+}
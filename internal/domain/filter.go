@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// 페이지 크기 제한
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// UserFilter 사용자 목록 필터
+type UserFilter struct {
+	Status        string
+	IsVerified    *bool
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	EmailPrefix   string
+	NamePrefix    string
+}
+
+// Page 커서 기반 페이지 요청 (created_at, _id 기준 keyset 정렬)
+type Page struct {
+	Cursor     string
+	Limit      int
+	Descending bool
+}
+
+// UserPage 사용자 목록 페이지 결과
+type UserPage struct {
+	Users      []*User
+	NextCursor string
+}
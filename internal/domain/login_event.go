@@ -0,0 +1,37 @@
+// quser/internal/domain/login_event.go
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 로그인 실패 사유
+const (
+	LoginFailureUserNotFound    = "user_not_found"
+	LoginFailureInvalidPassword = "invalid_password"
+	LoginFailureAccountLocked   = "account_locked"
+	LoginFailureTokenIssue      = "token_issue_failed"
+	LoginFailureInternal        = "internal_error"
+)
+
+// ClientInfo 요청한 클라이언트 정보
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// LoginEvent 로그인 시도 기록
+//
+// 존재하지 않는 이메일로 시도한 경우에는 UserID가 비어 있다.
+type LoginEvent struct {
+	ID            primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	UserID        primitive.ObjectID `json:"-" bson:"user_id,omitempty"`
+	Success       bool               `json:"success" bson:"success"`
+	FailureReason string             `json:"failure_reason,omitempty" bson:"failure_reason,omitempty"`
+	IP            string             `json:"ip" bson:"ip"`
+	UserAgent     string             `json:"user_agent" bson:"user_agent"`
+	CreatedAt     time.Time          `json:"created_at" bson:"created_at"`
+	ExpiresAt     time.Time          `json:"-" bson:"expires_at"` // 보관 기간이 지나면 TTL 인덱스로 삭제
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// 역할 상수
+const (
+	RoleAdmin = "admin"
+)
+
+// Principal 인증된 요청 주체
+type Principal struct {
+	UserID    string
+	SessionID string
+	Roles     []string
+	ExpiresAt time.Time
+}
+
+// HasRole 주어진 역할 보유 여부 확인
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal 컨텍스트에 인증 주체 저장
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext 컨텍스트에서 인증 주체 조회
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
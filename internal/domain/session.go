@@ -0,0 +1,22 @@
+// quser/internal/domain/session.go
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Session 로그인으로 발급된 토큰 단위의 세션
+type Session struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id"`
+	UserID     primitive.ObjectID `json:"-" bson:"user_id"`
+	Device     string             `json:"device" bson:"device"`
+	IP         string             `json:"ip" bson:"ip"`
+	UserAgent  string             `json:"user_agent" bson:"user_agent"`
+	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
+	LastSeenAt time.Time          `json:"last_seen_at" bson:"last_seen_at"`
+	ExpiresAt  time.Time          `json:"expires_at" bson:"expires_at"` // 토큰 만료 시각 (이후 TTL 인덱스로 삭제)
+	RevokedAt  *time.Time         `json:"-" bson:"revoked_at,omitempty"`
+	Current    bool               `json:"current" bson:"-"` // 요청에 사용된 세션 여부
+}
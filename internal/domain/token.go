@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UserToken 일회용 사용자 토큰 (원본 토큰은 저장하지 않고 해시만 보관)
+type UserToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	TokenHash string             `bson:"token_hash"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
@@ -11,25 +11,50 @@ const (
 	UserStatusActive   = "active"
 	UserStatusInactive = "inactive"
 	UserStatusPending  = "pending"
+	UserStatusDeleted  = "deleted"
 )
 
 // User 도메인 모델
 type User struct {
 	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
-	Email      string             `json:"email" bson:"email"`
-	Password   string             `json:"-" bson:"password"` // JSON 직렬화에서 제외
+	Email      string             `json:"email" bson:"email"`        // 표시용 이메일
+	EmailNorm  string             `json:"-" bson:"email_normalized"` // 중복 판별용 정규화 이메일
+	Password   string             `json:"-" bson:"password"`         // JSON 직렬화에서 제외
 	Name       string             `json:"name" bson:"name"`
 	Status     string             `json:"status" bson:"status"`
 	CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
 	UpdatedAt  time.Time          `json:"updated_at" bson:"updated_at"`
 	IsVerified bool               `json:"is_verified" bson:"is_verified"`
 	Profile    *UserProfile       `json:"profile,omitempty" bson:"profile,omitempty"`
+	DeletedAt  *time.Time         `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	PrevStatus string             `json:"-" bson:"previous_status,omitempty"` // 삭제 직전 상태 (복구 시 되돌림)
+
+	// 로그인 실패 기록 (연속 실패 시 점진적 잠금)
+	FailedLoginCount  int        `json:"-" bson:"failed_login_count,omitempty"`
+	LockedUntil       *time.Time `json:"-" bson:"locked_until,omitempty"`
+	LastFailedLoginAt *time.Time `json:"-" bson:"last_failed_login_at,omitempty"`
+}
+
+// LockoutPolicy 로그인 실패 잠금 정책
+//
+// 연속 실패가 Threshold에 도달하면 BaseDuration만큼 잠그고, 이후 실패마다 잠금 시간을 두 배로
+// 늘려 MaxDuration까지 증가시킨다. 마지막 실패 후 ResetAfter가 지나면 실패 횟수를 새로 센다.
+type LockoutPolicy struct {
+	Threshold    int
+	BaseDuration time.Duration
+	MaxDuration  time.Duration
+	ResetAfter   time.Duration
+}
+
+// IsLocked 특정 시각에 계정이 잠겨 있는지 확인
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
 }
 
 // UserProfile 도메인 모델
 type UserProfile struct {
-	PhoneNumber string    `json:"phone_number,omitempty" bson:"phone_number,omitempty"`
-	Avatar      string    `json:"avatar,omitempty" bson:"avatar,omitempty"`
-	Bio         string    `json:"bio,omitempty" bson:"bio,omitempty"`
-	LastLogin   time.Time `json:"last_login,omitempty" bson:"last_login,omitempty"`
+	PhoneNumber string     `json:"phone_number,omitempty" bson:"phone_number,omitempty"`
+	Avatar      string     `json:"avatar,omitempty" bson:"avatar,omitempty"`
+	Bio         string     `json:"bio,omitempty" bson:"bio,omitempty"`
+	LastLogin   *time.Time `json:"last_login,omitempty" bson:"last_login,omitempty"`
 }
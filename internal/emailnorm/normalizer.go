@@ -0,0 +1,107 @@
+// quser/internal/emailnorm/normalizer.go
+package emailnorm
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// ErrInvalidEmail 이메일 형식이 올바르지 않을 때 반환
+var ErrInvalidEmail = errors.New("잘못된 이메일 형식입니다")
+
+// providerRule 메일 제공자별 정규화 규칙
+type providerRule struct {
+	stripDots       bool   // 로컬 파트의 '.' 무시
+	stripPlusTag    bool   // '+' 이후 태그 무시
+	canonicalDomain string // 별칭 도메인을 대표 도메인으로 통합
+}
+
+var providerRules = map[string]providerRule{
+	"gmail.com":      {stripDots: true, stripPlusTag: true, canonicalDomain: "gmail.com"},
+	"googlemail.com": {stripDots: true, stripPlusTag: true, canonicalDomain: "gmail.com"},
+	"outlook.com":    {stripPlusTag: true},
+	"hotmail.com":    {stripPlusTag: true},
+	"live.com":       {stripPlusTag: true},
+	"icloud.com":     {stripPlusTag: true},
+	"me.com":         {stripPlusTag: true},
+	"fastmail.com":   {stripPlusTag: true},
+	"protonmail.com": {stripPlusTag: true},
+	"proton.me":      {stripPlusTag: true},
+}
+
+// Normalizer 이메일 정규화 컴포넌트
+type Normalizer struct {
+	// 제공자별 규칙 적용 여부 (변경 시 기존 정규화 값 재계산 필요)
+	applyProviderRules bool
+}
+
+// NewNormalizer 이메일 정규화 컴포넌트 생성자
+func NewNormalizer(applyProviderRules bool) *Normalizer {
+	return &Normalizer{applyProviderRules: applyProviderRules}
+}
+
+// Normalize 표시용 이메일과 중복 판별용 정규화 이메일을 반환
+//
+// 표시용: 공백 제거, 도메인 소문자화 및 IDNA(punycode) 변환
+// 정규화: 표시용 이메일 전체 소문자화 + 제공자별 규칙
+func (n *Normalizer) Normalize(raw string) (string, string, error) {
+	email := strings.TrimSpace(raw)
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", "", ErrInvalidEmail
+	}
+	local, domain := email[:at], email[at+1:]
+	if strings.ContainsAny(local, " \t\r\n") {
+		return "", "", ErrInvalidEmail
+	}
+
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(strings.ToLower(domain), "."))
+	if err != nil || !strings.Contains(domain, ".") {
+		return "", "", ErrInvalidEmail
+	}
+
+	display := local + "@" + domain
+	canonicalLocal := strings.ToLower(local)
+
+	if n.applyProviderRules {
+		if rule, ok := providerRules[domain]; ok {
+			if rule.stripPlusTag {
+				if i := strings.Index(canonicalLocal, "+"); i > 0 {
+					canonicalLocal = canonicalLocal[:i]
+				}
+			}
+			if rule.stripDots {
+				canonicalLocal = strings.ReplaceAll(canonicalLocal, ".", "")
+			}
+			if rule.canonicalDomain != "" {
+				domain = rule.canonicalDomain
+			}
+		}
+	}
+	if canonicalLocal == "" {
+		return "", "", ErrInvalidEmail
+	}
+
+	return display, canonicalLocal + "@" + domain, nil
+}
+
+// Canonical 정규화 이메일만 반환
+func (n *Normalizer) Canonical(raw string) (string, error) {
+	_, canonical, err := n.Normalize(raw)
+	return canonical, err
+}
+
+// CanonicalPrefix 검색용 이메일 접두어 정규화
+//
+// 완전한 주소면 Canonical과 같은 값을, 입력 중인 일부라면 소문자화만 적용한 값을 반환한다.
+// 제공자별 규칙은 도메인을 알아야 적용할 수 있으므로 일부 입력에는 적용되지 않는다.
+func (n *Normalizer) CanonicalPrefix(raw string) string {
+	prefix := strings.TrimSpace(raw)
+	if canonical, err := n.Canonical(prefix); err == nil {
+		return canonical
+	}
+	return strings.ToLower(prefix)
+}
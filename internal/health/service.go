@@ -0,0 +1,136 @@
+// quser/internal/health/service.go
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// 상태 값
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Checker 의존성 상태 확인 인터페이스
+type Checker interface {
+	// 확인 대상 이름
+	Name() string
+	// 상태 확인 (정상이면 nil)
+	Check(ctx context.Context) error
+}
+
+type checkFunc struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewCheck 함수를 Checker로 변환
+func NewCheck(name string, fn func(ctx context.Context) error) Checker {
+	return &checkFunc{name: name, fn: fn}
+}
+
+func (c *checkFunc) Name() string {
+	return c.name
+}
+
+func (c *checkFunc) Check(ctx context.Context) error {
+	return c.fn(ctx)
+}
+
+// CheckResult 개별 의존성 확인 결과 (공개 응답이므로 실패 원인은 로그에만 기록)
+type CheckResult struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+}
+
+// Report 전체 상태 확인 결과
+type Report struct {
+	Status    string        `json:"status"`
+	Checks    []CheckResult `json:"checks"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+// Healthy 모든 의존성이 정상인지 여부
+func (r *Report) Healthy() bool {
+	return r.Status == StatusUp
+}
+
+// Service 의존성 상태 확인 서비스 (프로브가 의존성에 부하를 주지 않도록 결과를 짧게 캐시)
+type Service struct {
+	checkers []Checker
+	cacheTTL time.Duration
+	timeout  time.Duration
+	logger   *slog.Logger
+
+	mu       sync.Mutex
+	cached   *Report
+	cachedAt time.Time
+}
+
+// NewService 상태 확인 서비스 생성자
+func NewService(cacheTTL, timeout time.Duration, logger *slog.Logger, checkers ...Checker) *Service {
+	return &Service{
+		checkers: checkers,
+		cacheTTL: cacheTTL,
+		timeout:  timeout,
+		logger:   logger.With("component", "health"),
+	}
+}
+
+// Check 캐시가 유효하면 캐시된 결과를, 아니면 모든 의존성을 동시에 확인한 결과를 반환
+func (s *Service) Check(ctx context.Context) *Report {
+	// 동시에 들어온 프로브는 하나의 확인 결과를 공유
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && time.Since(s.cachedAt) < s.cacheTTL {
+		return s.cached
+	}
+
+	// 결과를 다른 프로브와 공유하므로 먼저 온 프로브의 연결이 끊겨도 확인은 설정된 제한 시간까지 진행
+	checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.timeout)
+	defer cancel()
+
+	results := make([]CheckResult, len(s.checkers))
+	var wg sync.WaitGroup
+	for i, checker := range s.checkers {
+		wg.Add(1)
+		go func(i int, checker Checker) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := checker.Check(checkCtx)
+			result := CheckResult{
+				Name:      checker.Name(),
+				Status:    StatusUp,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				result.Status = StatusDown
+				s.logger.WarnContext(ctx, "의존성 상태 확인 실패", "check", result.Name, "error", err)
+			}
+			results[i] = result
+		}(i, checker)
+	}
+	wg.Wait()
+
+	report := &Report{
+		Status:    StatusUp,
+		Checks:    results,
+		CheckedAt: time.Now(),
+	}
+	for _, result := range results {
+		if result.Status != StatusUp {
+			report.Status = StatusDown
+			break
+		}
+	}
+
+	s.cached = report
+	s.cachedAt = report.CheckedAt
+	return report
+}
@@ -0,0 +1,69 @@
+// quser/internal/lifecycle/manager.go
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+type shutdownHook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Manager 애플리케이션 준비 상태와 종료 순서 관리
+type Manager struct {
+	ready  atomic.Bool
+	logger *slog.Logger
+
+	mu    sync.Mutex
+	hooks []shutdownHook
+}
+
+// NewManager 생명주기 관리자 생성자 (초기 상태는 not-ready)
+func NewManager(logger *slog.Logger) *Manager {
+	return &Manager{logger: logger}
+}
+
+// SetReady 트래픽 수신 가능 여부 설정
+func (m *Manager) SetReady(ready bool) {
+	m.ready.Store(ready)
+}
+
+// Ready 트래픽 수신 가능 여부
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+// OnShutdown 종료 시 실행할 작업 등록 (등록의 역순으로 실행)
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.hooks = append(m.hooks, shutdownHook{name: name, fn: fn})
+}
+
+// Shutdown 등록된 종료 작업을 역순으로 실행 (실패해도 나머지 작업은 계속 실행)
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.SetReady(false)
+
+	m.mu.Lock()
+	hooks := m.hooks
+	m.hooks = nil
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hook := hooks[i]
+		m.logger.InfoContext(ctx, "종료 작업 실행", "hook", hook.name)
+		if err := hook.fn(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s 종료 실패: %w", hook.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
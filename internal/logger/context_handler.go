@@ -0,0 +1,29 @@
+// quser/internal/logger/context_handler.go
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/signalable/quser/internal/requestid"
+)
+
+// contextHandler 컨텍스트의 요청 ID를 모든 로그에 추가하는 핸들러
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,102 @@
+// quser/internal/logger/logger.go
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys 값 전체를 가리는 속성 키 (소문자)
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"new_password":  true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"authorization": true,
+	"secret":        true,
+	"smtp_password": true,
+}
+
+var (
+	emailPattern      = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	bearerPattern     = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+	tokenParamPattern = regexp.MustCompile(`(?i)([?&](?:token|access_token)=)[^&\s]+`)
+)
+
+// New 설정에 따른 로거 생성 (level: debug|info|warn|error, format: text|json)
+func New(level, format string) *slog.Logger {
+	return NewWithWriter(os.Stdout, level, format)
+}
+
+// NewWithWriter 지정한 출력으로 로거 생성
+func NewWithWriter(w io.Writer, level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       parseLevel(level),
+		ReplaceAttr: redact,
+	}
+
+	var handler slog.Handler
+	if strings.EqualFold(format, "json") {
+		handler = slog.NewJSONHandler(w, opts)
+	} else {
+		handler = slog.NewTextHandler(w, opts)
+	}
+	return slog.New(contextHandler{handler})
+}
+
+// MaskEmail 이메일 로컬 파트를 가림 (foo@example.com → f***@example.com)
+func MaskEmail(s string) string {
+	return emailPattern.ReplaceAllStringFunc(s, func(email string) string {
+		at := strings.LastIndex(email, "@")
+		if at <= 0 {
+			return redacted
+		}
+		return email[:1] + "***" + email[at:]
+	})
+}
+
+// Redact 문자열에 포함된 이메일, Bearer 토큰, 토큰 쿼리 파라미터 가림
+func Redact(s string) string {
+	s = bearerPattern.ReplaceAllString(s, "${1}"+redacted)
+	s = tokenParamPattern.ReplaceAllString(s, "${1}"+redacted)
+	return MaskEmail(s)
+}
+
+// redact 민감한 속성 값 가림 (비밀번호·토큰은 전체, 이메일은 부분 마스킹)
+func redact(groups []string, a slog.Attr) slog.Attr {
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redacted)
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, Redact(a.Value.String()))
+	case slog.KindAny:
+		// 구조체, 슬라이스, 맵, Stringer도 이메일이나 토큰을 담을 수 있으므로 출력될 문자열 기준으로 가림
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, Redact(err.Error()))
+		}
+		return slog.String(a.Key, Redact(fmt.Sprint(a.Value.Any())))
+	}
+	return a
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type profileDTO struct {
+	Name  string
+	Email string
+}
+
+type link string
+
+func (l link) String() string { return "https://example.com/verify?token=" + string(l) }
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"email", "user foo.bar@example.com signed in", "user f***@example.com signed in"},
+		{"bearer token", "Authorization: Bearer abc.def-ghi", "Authorization: Bearer " + redacted},
+		{"token query parameter", "/verify?token=secret&x=1", "/verify?token=" + redacted + "&x=1"},
+		{"nothing sensitive", "plain message", "plain message"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(tt.in); got != tt.want {
+				t.Errorf("Redact(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggerRedactsAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   any
+		want    string
+		notWant string
+	}{
+		{"sensitive key", "password", "hunter2", redacted, "hunter2"},
+		{"string value", "email", "alice@example.com", "a***@example.com", "alice@example.com"},
+		{"error value", "error", errors.New("no user bob@example.com"), "b***@example.com", "bob@example.com"},
+		{"struct value", "profile", profileDTO{Name: "Carol", Email: "carol@example.com"}, "c***@example.com", "carol@example.com"},
+		{"slice value", "emails", []string{"dave@example.com", "erin@example.com"}, "e***@example.com", "dave@example.com"},
+		{"map value", "recipients", map[string]string{"to": "frank@example.com"}, "f***@example.com", "frank@example.com"},
+		{"stringer value", "link", link("s3cr3t"), "token=" + redacted, "s3cr3t"},
+		{"non-sensitive value", "count", 3, "count=3", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			NewWithWriter(&buf, "info", "text").Info("test", tt.key, tt.value)
+
+			out := buf.String()
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("output %q does not contain %q", out, tt.want)
+			}
+			if tt.notWant != "" && strings.Contains(out, tt.notWant) {
+				t.Errorf("output %q contains %q", out, tt.notWant)
+			}
+		})
+	}
+}
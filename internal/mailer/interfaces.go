@@ -0,0 +1,17 @@
+// quser/internal/mailer/interfaces.go
+package mailer
+
+import "context"
+
+// Message 메일 메시지
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 메일 발송 인터페이스 정의
+type Mailer interface {
+	// 메일 발송
+	Send(ctx context.Context, msg *Message) error
+}
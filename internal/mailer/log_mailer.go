@@ -0,0 +1,53 @@
+// quser/internal/mailer/log_mailer.go
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// logMailer 로컬 개발용 메일러 (파일 또는 로그로 출력)
+type logMailer struct {
+	mu     sync.Mutex
+	path   string
+	logger *slog.Logger
+}
+
+// NewLogMailer 로그 메일러 생성자 (path가 비어 있으면 로거로 출력)
+//
+// 로거는 이메일과 토큰을 가리므로 인증 링크를 확인하려면 path를 지정한다.
+func NewLogMailer(path string, logger *slog.Logger) Mailer {
+	return &logMailer{path: path, logger: logger}
+}
+
+// Send 메일 내용을 파일 또는 로그에 기록
+func (m *logMailer) Send(ctx context.Context, msg *Message) error {
+	entry := fmt.Sprintf(
+		"[%s] To: %s\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC3339),
+		msg.To,
+		msg.Subject,
+		msg.Body,
+	)
+
+	if m.path == "" {
+		m.logger.InfoContext(ctx, "메일 발송(로그)", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("메일 파일 열기 실패: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(entry)
+	return err
+}
@@ -0,0 +1,114 @@
+// quser/internal/mailer/smtp_mailer.go
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type smtpMailer struct {
+	addr     string
+	host     string
+	from     string
+	username string
+	password string
+}
+
+// NewSMTPMailer SMTP 메일러 생성자
+func NewSMTPMailer(host, port, username, password, from string) Mailer {
+	return &smtpMailer{
+		addr:     net.JoinHostPort(host, port),
+		host:     host,
+		from:     from,
+		username: username,
+		password: password,
+	}
+}
+
+// Send SMTP로 메일 발송 (연결부터 전송 완료까지 ctx의 기한과 취소를 따름)
+func (m *smtpMailer) Send(ctx context.Context, msg *Message) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("SMTP 연결 실패: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("SMTP 연결 설정 실패: %w", err)
+		}
+	}
+	// 기한 없이 취소된 경우에도 진행 중인 읽기·쓰기를 즉시 중단
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := m.deliver(conn, msg); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("메일 발송 실패: %w", ctxErr)
+		}
+		return fmt.Errorf("메일 발송 실패: %w", err)
+	}
+	return nil
+}
+
+// deliver 연결된 SMTP 서버와 대화하여 메일 한 통 전송 (가능하면 STARTTLS 사용)
+func (m *smtpMailer) deliver(conn net.Conn, msg *Message) error {
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP 서버가 인증을 지원하지 않습니다")
+		}
+		if err := c.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(m.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(m.buildMessage(msg)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (m *smtpMailer) buildMessage(msg *Message) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + m.from + "\r\n")
+	b.WriteString("To: " + msg.To + "\r\n")
+	b.WriteString("Subject: " + msg.Subject + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	return []byte(b.String())
+}
@@ -0,0 +1,16 @@
+// quser/internal/metrics/auth_client.go
+package metrics
+
+import "time"
+
+// ObserveAuthCall Auth 서비스 호출 결과 기록 (client.CallObserver로 등록)
+//
+// 캐시로 응답한 토큰 검증은 호출되지 않으므로 token_cache_* 지표와 합쳐 보면 실제 호출 비율을 알 수 있다.
+func (m *Metrics) ObserveAuthCall(endpoint string, duration time.Duration, err error) {
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeError
+	}
+	m.authRequests.WithLabelValues(endpoint, outcome).Inc()
+	m.authDuration.WithLabelValues(endpoint).Observe(duration.Seconds())
+}
@@ -0,0 +1,168 @@
+// quser/internal/metrics/metrics.go
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/signalable/quser/internal/client"
+)
+
+const namespace = "quser"
+
+// 작업 결과 라벨 값
+const (
+	outcomeSuccess  = "success"
+	outcomeNotFound = "not_found"
+	outcomeError    = "error"
+)
+
+// 로그인 실패 사유 라벨 값
+const (
+	failedLoginBadCredentials = "bad_credentials"
+	failedLoginLocked         = "locked"
+)
+
+// Metrics 서비스 Prometheus 지표 모음
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	repoDuration *prometheus.HistogramVec
+	repoErrors   *prometheus.CounterVec
+
+	authRequests *prometheus.CounterVec
+	authDuration *prometheus.HistogramVec
+
+	registrations prometheus.Counter
+	logins        prometheus.Counter
+	failedLogins  *prometheus.CounterVec
+	verifications prometheus.Counter
+}
+
+// New 지표 생성 및 전용 레지스트리 등록
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP 요청 수 (라우트 템플릿, 메서드, 상태 코드별)",
+		}, []string{"route", "method", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP 요청 처리 시간",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		repoDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "operation_duration_seconds",
+			Help:      "레포지토리 작업 처리 시간",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"repository", "operation", "outcome"}),
+		repoErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "errors_total",
+			Help:      "레포지토리 작업 오류 수",
+		}, []string{"repository", "operation"}),
+		authRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth_client",
+			Name:      "requests_total",
+			Help:      "Auth 서비스 호출 수 (엔드포인트, 결과별)",
+		}, []string{"endpoint", "outcome"}),
+		authDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "auth_client",
+			Name:      "request_duration_seconds",
+			Help:      "Auth 서비스 호출 시간",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		registrations: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "registrations_total",
+			Help:      "회원가입 성공 수",
+		}),
+		logins: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "logins_total",
+			Help:      "로그인 성공 수",
+		}),
+		failedLogins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "failed_logins_total",
+			Help:      "로그인 실패 수 (bad_credentials: 잘못된 인증 정보, locked: 잠긴 계정 또는 이번 실패로 잠김)",
+		}, []string{"reason"}),
+		verifications: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "email_verifications_total",
+			Help:      "이메일 인증 성공 수",
+		}),
+	}
+
+	// 실패가 없어도 두 사유 모두 0으로 노출
+	m.failedLogins.WithLabelValues(failedLoginBadCredentials)
+	m.failedLogins.WithLabelValues(failedLoginLocked)
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.repoDuration,
+		m.repoErrors,
+		m.authRequests,
+		m.authDuration,
+		m.registrations,
+		m.logins,
+		m.failedLogins,
+		m.verifications,
+	)
+
+	return m
+}
+
+// Handler /metrics 노출 핸들러
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// RegisterTokenCache 토큰 검증 캐시 통계를 수집 시점에 읽어 노출
+func (m *Metrics) RegisterTokenCache(stats func() client.CacheStats) {
+	m.registry.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth_client",
+			Name:      "token_cache_hits_total",
+			Help:      "토큰 검증 캐시 적중 수",
+		}, func() float64 { return float64(stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth_client",
+			Name:      "token_cache_misses_total",
+			Help:      "토큰 검증 캐시 미스 수",
+		}, func() float64 { return float64(stats().Misses) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "auth_client",
+			Name:      "token_cache_entries",
+			Help:      "토큰 검증 캐시 항목 수",
+		}, func() float64 { return float64(stats().Entries) }),
+	)
+}
+
+// ObserveHTTPRequest HTTP 요청 결과 기록
+func (m *Metrics) ObserveHTTPRequest(route, method, status string, seconds float64) {
+	m.httpRequests.WithLabelValues(route, method, status).Inc()
+	m.httpDuration.WithLabelValues(route, method, status).Observe(seconds)
+}
@@ -0,0 +1,159 @@
+// quser/internal/metrics/user_repository.go
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/signalable/quser/internal/domain"
+	"github.com/signalable/quser/internal/repository"
+)
+
+const userRepositoryLabel = "user"
+
+// instrumentedUserRepository 작업 시간과 오류를 기록하는 UserRepository 데코레이터
+type instrumentedUserRepository struct {
+	next    repository.UserRepository
+	metrics *Metrics
+}
+
+// NewInstrumentedUserRepository 지표 수집 UserRepository 생성자
+func NewInstrumentedUserRepository(next repository.UserRepository, metrics *Metrics) repository.UserRepository {
+	return &instrumentedUserRepository{
+		next:    next,
+		metrics: metrics,
+	}
+}
+
+func (r *instrumentedUserRepository) observe(operation string, start time.Time, err error) {
+	outcome := outcomeSuccess
+	switch {
+	case err == nil:
+	case errors.Is(err, domain.ErrUserNotFound):
+		outcome = outcomeNotFound
+	default:
+		outcome = outcomeError
+		r.metrics.repoErrors.WithLabelValues(userRepositoryLabel, operation).Inc()
+	}
+	r.metrics.repoDuration.WithLabelValues(userRepositoryLabel, operation, outcome).Observe(time.Since(start).Seconds())
+}
+
+func (r *instrumentedUserRepository) Create(ctx context.Context, user *domain.User) error {
+	start := time.Now()
+	err := r.next.Create(ctx, user)
+	r.observe("create", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) FindByEmail(ctx context.Context, normalizedEmail string) (*domain.User, error) {
+	start := time.Now()
+	user, err := r.next.FindByEmail(ctx, normalizedEmail)
+	r.observe("find_by_email", start, err)
+	return user, err
+}
+
+func (r *instrumentedUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	start := time.Now()
+	user, err := r.next.FindByID(ctx, id)
+	r.observe("find_by_id", start, err)
+	return user, err
+}
+
+func (r *instrumentedUserRepository) ExistsByEmail(ctx context.Context, normalizedEmail string) (bool, error) {
+	start := time.Now()
+	exists, err := r.next.ExistsByEmail(ctx, normalizedEmail)
+	r.observe("exists_by_email", start, err)
+	return exists, err
+}
+
+func (r *instrumentedUserRepository) Update(ctx context.Context, user *domain.User) error {
+	start := time.Now()
+	err := r.next.Update(ctx, user)
+	r.observe("update", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) UpdateProfile(ctx context.Context, userID string, profile *domain.UserProfile) error {
+	start := time.Now()
+	err := r.next.UpdateProfile(ctx, userID, profile)
+	r.observe("update_profile", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) UpdateVerificationStatus(ctx context.Context, userID string, isVerified bool) error {
+	start := time.Now()
+	err := r.next.UpdateVerificationStatus(ctx, userID, isVerified)
+	r.observe("update_verification_status", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) UpdateStatus(ctx context.Context, userID string, status string) error {
+	start := time.Now()
+	err := r.next.UpdateStatus(ctx, userID, status)
+	r.observe("update_status", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) UpdatePassword(ctx context.Context, userID string, passwordHash string) error {
+	start := time.Now()
+	err := r.next.UpdatePassword(ctx, userID, passwordHash)
+	r.observe("update_password", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) UpdateLastLogin(ctx context.Context, userID string, at time.Time) error {
+	start := time.Now()
+	err := r.next.UpdateLastLogin(ctx, userID, at)
+	r.observe("update_last_login", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) RecordFailedLogin(ctx context.Context, userID string, at time.Time, policy domain.LockoutPolicy) (*domain.User, error) {
+	start := time.Now()
+	user, err := r.next.RecordFailedLogin(ctx, userID, at, policy)
+	r.observe("record_failed_login", start, err)
+	return user, err
+}
+
+func (r *instrumentedUserRepository) ResetFailedLogins(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := r.next.ResetFailedLogins(ctx, userID)
+	r.observe("reset_failed_logins", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) List(ctx context.Context, filter domain.UserFilter, page domain.Page) (*domain.UserPage, error) {
+	start := time.Now()
+	result, err := r.next.List(ctx, filter, page)
+	r.observe("list", start, err)
+	return result, err
+}
+
+func (r *instrumentedUserRepository) SoftDelete(ctx context.Context, userID string, deletedAt time.Time) error {
+	start := time.Now()
+	err := r.next.SoftDelete(ctx, userID, deletedAt)
+	r.observe("soft_delete", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) Restore(ctx context.Context, userID string, deletedAfter time.Time) error {
+	start := time.Now()
+	err := r.next.Restore(ctx, userID, deletedAfter)
+	r.observe("restore", start, err)
+	return err
+}
+
+func (r *instrumentedUserRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	start := time.Now()
+	users, err := r.next.FindDeletedBefore(ctx, before, limit)
+	r.observe("find_deleted_before", start, err)
+	return users, err
+}
+
+func (r *instrumentedUserRepository) Delete(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := r.next.Delete(ctx, userID)
+	r.observe("delete", start, err)
+	return err
+}
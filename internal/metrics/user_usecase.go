@@ -0,0 +1,54 @@
+// quser/internal/metrics/user_usecase.go
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/signalable/quser/internal/domain"
+	"github.com/signalable/quser/internal/usecase"
+)
+
+// instrumentedUserUseCase 비즈니스 지표(가입, 로그인, 인증)를 기록하는 UserUseCase 데코레이터
+type instrumentedUserUseCase struct {
+	usecase.UserUseCase
+	metrics *Metrics
+}
+
+// NewInstrumentedUserUseCase 지표 수집 UserUseCase 생성자
+func NewInstrumentedUserUseCase(next usecase.UserUseCase, metrics *Metrics) usecase.UserUseCase {
+	return &instrumentedUserUseCase{
+		UserUseCase: next,
+		metrics:     metrics,
+	}
+}
+
+func (uc *instrumentedUserUseCase) Register(ctx context.Context, req *domain.RegisterRequest) error {
+	err := uc.UserUseCase.Register(ctx, req)
+	if err == nil {
+		uc.metrics.registrations.Inc()
+	}
+	return err
+}
+
+func (uc *instrumentedUserUseCase) Login(ctx context.Context, email, password string, clientInfo domain.ClientInfo) (*domain.LoginResponse, error) {
+	resp, err := uc.UserUseCase.Login(ctx, email, password, clientInfo)
+	switch {
+	case err == nil:
+		uc.metrics.logins.Inc()
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		uc.metrics.failedLogins.WithLabelValues(failedLoginBadCredentials).Inc()
+	case errors.Is(err, domain.ErrAccountLocked):
+		// 이미 잠긴 계정과 이번 실패로 잠긴 계정 모두 포함 (무차별 대입 중 실패 수가 줄어 보이지 않도록)
+		uc.metrics.failedLogins.WithLabelValues(failedLoginLocked).Inc()
+	}
+	return resp, err
+}
+
+func (uc *instrumentedUserUseCase) VerifyEmail(ctx context.Context, userID string, token string) error {
+	err := uc.UserUseCase.VerifyEmail(ctx, userID, token)
+	if err == nil {
+		uc.metrics.verifications.Inc()
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+// quser/internal/ratelimit/bucket.go
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// Limit 토큰 버킷 제한 (Period 동안 Capacity개의 토큰이 고르게 충전됨)
+type Limit struct {
+	Capacity int
+	Period   time.Duration
+}
+
+// Enabled 제한 적용 여부 (값이 비어 있으면 제한하지 않음)
+func (l Limit) Enabled() bool {
+	return l.Capacity > 0 && l.Period > 0
+}
+
+// RefillRate 초당 충전되는 토큰 수
+func (l Limit) RefillRate() float64 {
+	return float64(l.Capacity) / l.Period.Seconds()
+}
+
+// Result 토큰 소비 결과
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration // 거부된 경우 다음 토큰이 충전될 때까지의 시간
+}
+
+// Bucket 토큰 버킷 상태
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Take 버킷을 충전하고 토큰 하나를 소비한 새 상태와 결과 반환 (비어 있는 버킷은 가득 찬 상태로 시작)
+func (b Bucket) Take(limit Limit, now time.Time) (Bucket, Result) {
+	tokens := float64(limit.Capacity)
+	if !b.UpdatedAt.IsZero() {
+		elapsed := now.Sub(b.UpdatedAt).Seconds()
+		if elapsed < 0 {
+			elapsed = 0
+		}
+		tokens = math.Min(float64(limit.Capacity), b.Tokens+elapsed*limit.RefillRate())
+	}
+
+	next := Bucket{Tokens: tokens, UpdatedAt: now}
+	if tokens < 1 {
+		return next, Result{RetryAfter: limit.TimeToNextToken(tokens)}
+	}
+
+	next.Tokens--
+	return next, Result{Allowed: true, Remaining: int(next.Tokens)}
+}
+
+// TimeToNextToken 현재 토큰 수에서 토큰 하나가 충전될 때까지의 시간
+func (l Limit) TimeToNextToken(tokens float64) time.Duration {
+	if tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - tokens) / l.RefillRate() * float64(time.Second))
+}
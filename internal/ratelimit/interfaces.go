@@ -0,0 +1,15 @@
+// quser/internal/ratelimit/interfaces.go
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store 토큰 버킷 상태 저장소
+//
+// Take는 key의 버킷을 now 기준으로 충전한 뒤 토큰 하나를 소비한다.
+// 여러 인스턴스가 같은 저장소를 공유할 수 있도록 조회와 갱신은 원자적이어야 한다.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
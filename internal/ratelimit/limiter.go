@@ -0,0 +1,61 @@
+// quser/internal/ratelimit/limiter.go
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Key 제한을 적용할 버킷 키와 제한 값
+type Key struct {
+	Name  string
+	Limit Limit
+}
+
+// Limiter 여러 버킷을 함께 확인하는 요청 제한기
+type Limiter struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewLimiter 요청 제한기 생성자
+func NewLimiter(store Store) *Limiter {
+	return &Limiter{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Allow 모든 키의 버킷에서 토큰을 소비하고 하나라도 거부되면 가장 긴 대기 시간을 반환
+//
+// 거부된 요청도 다른 버킷의 토큰을 소비하므로 키 하나를 바꿔 가며 우회할 수 없다.
+func (l *Limiter) Allow(ctx context.Context, keys ...Key) (Result, error) {
+	now := l.now()
+	result := Result{Allowed: true, Remaining: -1}
+
+	for _, key := range keys {
+		if !key.Limit.Enabled() {
+			continue
+		}
+
+		r, err := l.store.Take(ctx, key.Name, key.Limit, now)
+		if err != nil {
+			return Result{}, err
+		}
+
+		if !r.Allowed {
+			result.Allowed = false
+			if r.RetryAfter > result.RetryAfter {
+				result.RetryAfter = r.RetryAfter
+			}
+		}
+		if result.Remaining < 0 || r.Remaining < result.Remaining {
+			result.Remaining = r.Remaining
+		}
+	}
+
+	if result.Remaining < 0 {
+		result.Remaining = 0
+	}
+	return result, nil
+}
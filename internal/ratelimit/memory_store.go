@@ -0,0 +1,63 @@
+// quser/internal/ratelimit/memory_store.go
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval 가득 찬 버킷을 정리하는 주기
+const sweepInterval = time.Minute
+
+type memoryEntry struct {
+	bucket  Bucket
+	expires time.Time // 이 시각 이후에는 버킷이 가득 차므로 삭제해도 동일
+}
+
+// memoryStore 단일 인스턴스용 메모리 저장소
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryEntry
+	lastSweep time.Time
+}
+
+// NewMemoryStore 메모리 저장소 생성자
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets: make(map[string]*memoryEntry),
+	}
+}
+
+// Take 토큰 소비
+func (s *memoryStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	entry, ok := s.buckets[key]
+	if !ok {
+		entry = &memoryEntry{}
+		s.buckets[key] = entry
+	}
+
+	var result Result
+	entry.bucket, result = entry.bucket.Take(limit, now)
+	entry.expires = now.Add(limit.Period)
+	return result, nil
+}
+
+// sweep 만료된 버킷 정리 (호출자가 잠금을 보유)
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.buckets {
+		if now.After(entry.expires) {
+			delete(s.buckets, key)
+		}
+	}
+}
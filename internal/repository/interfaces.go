@@ -3,6 +3,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/signalable/quser/internal/domain"
 )
@@ -11,12 +12,12 @@ import (
 type UserRepository interface {
 	// 사용자 생성
 	Create(ctx context.Context, user *domain.User) error
-	// 이메일로 사용자 찾기
-	FindByEmail(ctx context.Context, email string) (*domain.User, error)
+	// 정규화된 이메일로 사용자 찾기
+	FindByEmail(ctx context.Context, normalizedEmail string) (*domain.User, error)
 	// ID로 사용자 찾기
 	FindByID(ctx context.Context, id string) (*domain.User, error)
-	// 이메일 존재 여부 확인
-	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	// 정규화된 이메일 존재 여부 확인
+	ExistsByEmail(ctx context.Context, normalizedEmail string) (bool, error)
 	// 사용자 정보 업데이트
 	Update(ctx context.Context, user *domain.User) error
 	// 프로필 업데이트
@@ -25,4 +26,64 @@ type UserRepository interface {
 	UpdateVerificationStatus(ctx context.Context, userID string, isVerified bool) error
 	// 사용자 상태 업데이트
 	UpdateStatus(ctx context.Context, userID string, status string) error
+	// 비밀번호 해시 업데이트
+	UpdatePassword(ctx context.Context, userID string, passwordHash string) error
+	// 마지막 로그인 시각 갱신
+	UpdateLastLogin(ctx context.Context, userID string, at time.Time) error
+	// 로그인 실패 기록 및 정책에 따른 잠금 (갱신된 사용자 반환)
+	RecordFailedLogin(ctx context.Context, userID string, at time.Time, policy domain.LockoutPolicy) (*domain.User, error)
+	// 로그인 실패 횟수와 잠금 초기화
+	ResetFailedLogins(ctx context.Context, userID string) error
+	// 사용자 목록 조회 (필터 + 커서 페이지네이션)
+	List(ctx context.Context, filter domain.UserFilter, page domain.Page) (*domain.UserPage, error)
+	// 사용자 소프트 삭제
+	SoftDelete(ctx context.Context, userID string, deletedAt time.Time) error
+	// 소프트 삭제된 사용자 복구 (deletedAfter 이후 삭제된 경우만)
+	Restore(ctx context.Context, userID string, deletedAfter time.Time) error
+	// 특정 시각 이전에 소프트 삭제된 사용자 조회
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain.User, error)
+	// 사용자 영구 삭제
+	Delete(ctx context.Context, userID string) error
+}
+
+// UserTokenRepository 일회용 사용자 토큰 레포지토리 인터페이스 정의
+type UserTokenRepository interface {
+	// 토큰 저장
+	Create(ctx context.Context, token *domain.UserToken) error
+	// 유효한 토큰을 사용 처리하고 반환 (단 한 번만 성공)
+	Consume(ctx context.Context, tokenHash string) (*domain.UserToken, error)
+	// 지정한 사용자의 유효한 토큰만 사용 처리하고 반환
+	ConsumeForUser(ctx context.Context, userID, tokenHash string) (*domain.UserToken, error)
+	// 특정 시각 이후 발급된 토큰 수 조회
+	CountCreatedSince(ctx context.Context, userID string, since time.Time) (int64, error)
+	// 사용자의 토큰 전체 삭제
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+// LoginEventRepository 로그인 기록 레포지토리 인터페이스 정의
+type LoginEventRepository interface {
+	// 로그인 시도 기록 저장
+	Create(ctx context.Context, event *domain.LoginEvent) error
+	// 사용자의 최근 로그인 기록 조회 (최신순)
+	ListByUserID(ctx context.Context, userID string, limit int) ([]*domain.LoginEvent, error)
+	// 사용자의 로그인 기록 전체 삭제
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+// SessionRepository 로그인 세션 레포지토리 인터페이스 정의
+type SessionRepository interface {
+	// 세션 저장
+	Create(ctx context.Context, session *domain.Session) error
+	// 사용자의 활성 세션 조회 (최근 활동순)
+	ListActiveByUserID(ctx context.Context, userID string, now time.Time) ([]*domain.Session, error)
+	// 사용자의 활성 세션 단건 조회
+	FindActive(ctx context.Context, userID, sessionID string, now time.Time) (*domain.Session, error)
+	// 마지막 활동 시각 갱신 (minInterval 이내에 갱신된 세션은 건너뜀)
+	Touch(ctx context.Context, sessionID string, at time.Time, minInterval time.Duration) error
+	// 세션 폐기 처리
+	Revoke(ctx context.Context, userID, sessionID string, at time.Time) error
+	// 사용자의 모든 세션 폐기 처리
+	RevokeAllByUserID(ctx context.Context, userID string, at time.Time) error
+	// 사용자의 세션 전체 삭제
+	DeleteByUserID(ctx context.Context, userID string) error
 }
@@ -0,0 +1,94 @@
+// quser/internal/repository/mongodb/indexes.go
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const usersCollection = "users"
+
+// collectionIndexes 컬렉션별 인덱스 정의
+var collectionIndexes = map[string][]mongo.IndexModel{
+	usersCollection: {
+		{
+			Keys:    bson.D{{Key: "email_normalized", Value: 1}},
+			Options: options.Index().SetName("email_normalized_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}},
+			Options: options.Index().SetName("status"),
+		},
+		{
+			Keys:    bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("created_at_id"),
+		},
+	},
+	emailVerificationTokenCollection: userTokenIndexes(),
+	passwordResetTokenCollection:     userTokenIndexes(),
+	loginEventCollection: {
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("user_id_created_at"),
+		},
+		{
+			// 보관 기간이 지난 기록 자동 삭제
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+	},
+	sessionCollection: {
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "last_seen_at", Value: -1}},
+			Options: options.Index().SetName("user_id_last_seen_at"),
+		},
+		{
+			// 토큰이 만료된 세션 자동 삭제
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+	},
+	rateLimitCollection: {
+		{
+			// 가득 찬 상태로 돌아간 버킷 자동 삭제
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+	},
+}
+
+func userTokenIndexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "token_hash", Value: 1}},
+			Options: options.Index().SetName("token_hash_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("user_id_created_at"),
+		},
+		{
+			// 만료된 토큰 자동 삭제
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+	}
+}
+
+// EnsureIndexes 기동 시 컬렉션 인덱스 생성 (이미 존재하면 무시되므로 매번 실행해도 안전)
+func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
+	if err := dropObsoleteIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	for collection, indexes := range collectionIndexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes); err != nil {
+			return fmt.Errorf("%s 인덱스 생성 실패: %w", collection, err)
+		}
+	}
+	return nil
+}
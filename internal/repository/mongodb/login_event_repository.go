@@ -0,0 +1,73 @@
+// quser/internal/repository/mongodb/login_event_repository.go
+package mongodb
+
+import (
+	"context"
+
+	"github.com/signalable/quser/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const loginEventCollection = "login_events"
+
+type loginEventRepository struct {
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+// NewLoginEventRepository 로그인 기록 레포지토리 생성자
+func NewLoginEventRepository(db *mongo.Database) *loginEventRepository {
+	return &loginEventRepository{
+		db:         db,
+		collection: db.Collection(loginEventCollection),
+	}
+}
+
+// Create 로그인 시도 기록 저장
+func (r *loginEventRepository) Create(ctx context.Context, event *domain.LoginEvent) error {
+	result, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	event.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// ListByUserID 사용자의 최근 로그인 기록 조회 (최신순)
+func (r *loginEventRepository) ListByUserID(ctx context.Context, userID string, limit int) ([]*domain.LoginEvent, error) {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cur, err := r.collection.Find(
+		ctx,
+		bson.M{"user_id": objectID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	events := make([]*domain.LoginEvent, 0)
+	if err := cur.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// DeleteByUserID 사용자의 로그인 기록 전체 삭제
+func (r *loginEventRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.DeleteMany(ctx, bson.M{"user_id": objectID})
+	return err
+}
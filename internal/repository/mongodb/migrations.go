@@ -0,0 +1,126 @@
+// quser/internal/repository/mongodb/migrations.go
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// obsoleteIndexes 더 이상 사용하지 않아 제거할 인덱스
+var obsoleteIndexes = map[string][]string{
+	// email_normalized unique 인덱스로 대체
+	usersCollection: {"email_unique"},
+}
+
+// BackfillNormalizedEmails email_normalized 필드가 없는 기존 사용자 문서에 정규화 이메일 저장
+//
+// 정규화에 실패한 이메일은 소문자화한 값을 그대로 사용한다.
+// EnsureIndexes 보다 먼저 실행해야 unique 인덱스 생성이 가능하다.
+func BackfillNormalizedEmails(ctx context.Context, db *mongo.Database, canonical func(string) (string, error)) (int, error) {
+	collection := db.Collection(usersCollection)
+
+	cur, err := collection.Find(
+		ctx,
+		bson.M{"email_normalized": bson.M{"$exists": false}},
+		options.Find().SetProjection(bson.M{"email": 1}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	updated := 0
+	for cur.Next(ctx) {
+		var doc struct {
+			ID    primitive.ObjectID `bson:"_id"`
+			Email string             `bson:"email"`
+		}
+		if err := cur.Decode(&doc); err != nil {
+			return updated, err
+		}
+
+		normalized, err := canonical(doc.Email)
+		if err != nil {
+			normalized = strings.ToLower(strings.TrimSpace(doc.Email))
+		}
+
+		if _, err := collection.UpdateOne(
+			ctx,
+			bson.M{"_id": doc.ID},
+			bson.M{"$set": bson.M{"email_normalized": normalized}},
+		); err != nil {
+			return updated, fmt.Errorf("email_normalized 갱신 실패 (user=%s): %w", doc.ID.Hex(), err)
+		}
+		updated++
+	}
+
+	return updated, cur.Err()
+}
+
+// EmailCollision 같은 정규화 이메일을 가진 사용자 목록
+type EmailCollision struct {
+	EmailNormalized string
+	UserIDs         []string
+}
+
+// FindNormalizedEmailCollisions 정규화 이메일이 겹치는 사용자 조회
+//
+// 겹치는 값이 있으면 email_normalized unique 인덱스를 만들 수 없으므로,
+// EnsureIndexes 전에 실행하여 정리해야 할 계정을 알린다.
+func FindNormalizedEmailCollisions(ctx context.Context, db *mongo.Database) ([]EmailCollision, error) {
+	cur, err := db.Collection(usersCollection).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"email_normalized": bson.M{"$exists": true}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      "$email_normalized",
+			"user_ids": bson.M{"$push": "$_id"},
+			"count":    bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	}, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var collisions []EmailCollision
+	for cur.Next(ctx) {
+		var doc struct {
+			EmailNormalized string               `bson:"_id"`
+			UserIDs         []primitive.ObjectID `bson:"user_ids"`
+		}
+		if err := cur.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		collision := EmailCollision{EmailNormalized: doc.EmailNormalized}
+		for _, id := range doc.UserIDs {
+			collision.UserIDs = append(collision.UserIDs, id.Hex())
+		}
+		collisions = append(collisions, collision)
+	}
+
+	return collisions, cur.Err()
+}
+
+// dropObsoleteIndexes 대체된 인덱스 제거 (없으면 무시)
+func dropObsoleteIndexes(ctx context.Context, db *mongo.Database) error {
+	for collection, names := range obsoleteIndexes {
+		for _, name := range names {
+			if _, err := db.Collection(collection).Indexes().DropOne(ctx, name); err != nil {
+				var cmdErr mongo.CommandError
+				if errors.As(err, &cmdErr) && (cmdErr.Name == "IndexNotFound" || cmdErr.Name == "NamespaceNotFound") {
+					continue
+				}
+				return fmt.Errorf("%s.%s 인덱스 제거 실패: %w", collection, name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// quser/internal/repository/mongodb/rate_limit_store.go
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/signalable/quser/internal/ratelimit"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const rateLimitCollection = "rate_limits"
+
+type rateLimitStore struct {
+	collection *mongo.Collection
+}
+
+// NewRateLimitStore 여러 인스턴스가 공유하는 MongoDB 요청 제한 저장소 생성자
+func NewRateLimitStore(db *mongo.Database) ratelimit.Store {
+	return &rateLimitStore{
+		collection: db.Collection(rateLimitCollection),
+	}
+}
+
+// Take 파이프라인 업데이트로 버킷 충전과 토큰 소비를 한 번에 원자적으로 수행
+func (s *rateLimitStore) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	capacity := float64(limit.Capacity)
+	elapsedSec := bson.M{"$divide": bson.A{
+		bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$updated_at", now}}}}}},
+		1000,
+	}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"tokens": bson.M{"$min": bson.A{
+				capacity,
+				bson.M{"$add": bson.A{
+					bson.M{"$ifNull": bson.A{"$tokens", capacity}},
+					bson.M{"$multiply": bson.A{elapsedSec, limit.RefillRate()}},
+				}},
+			}},
+			"updated_at": now,
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"allowed": bson.M{"$gte": bson.A{"$tokens", 1}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"tokens":     bson.M{"$cond": bson.A{"$allowed", bson.M{"$subtract": bson.A{"$tokens", 1}}, "$tokens"}},
+			"expires_at": now.Add(limit.Period),
+		}}},
+	}
+
+	var bucket struct {
+		Tokens  float64 `bson:"tokens"`
+		Allowed bool    `bson:"allowed"`
+	}
+	update := func() error {
+		return s.collection.FindOneAndUpdate(
+			ctx,
+			bson.M{"_id": key},
+			pipeline,
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&bucket)
+	}
+
+	// 같은 키의 첫 요청이 동시에 들어오면 한쪽 upsert가 중복 키로 실패하므로 (이제 문서가 있으니) 한 번 더 시도
+	err := update()
+	if mongo.IsDuplicateKeyError(err) {
+		err = update()
+	}
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	if !bucket.Allowed {
+		return ratelimit.Result{RetryAfter: limit.TimeToNextToken(bucket.Tokens)}, nil
+	}
+	return ratelimit.Result{Allowed: true, Remaining: int(bucket.Tokens)}, nil
+}
@@ -0,0 +1,159 @@
+// quser/internal/repository/mongodb/session_repository.go
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/signalable/quser/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const sessionCollection = "sessions"
+
+type sessionRepository struct {
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+// NewSessionRepository 로그인 세션 레포지토리 생성자
+func NewSessionRepository(db *mongo.Database) *sessionRepository {
+	return &sessionRepository{
+		db:         db,
+		collection: db.Collection(sessionCollection),
+	}
+}
+
+// activeSession 폐기되지 않고 만료되지 않은 세션 조건
+func activeSession(userID primitive.ObjectID, now time.Time) bson.M {
+	return bson.M{
+		"user_id":    userID,
+		"revoked_at": nil,
+		"expires_at": bson.M{"$gt": now},
+	}
+}
+
+// Create 세션 저장 (ID는 토큰 발급 전에 호출자가 생성)
+func (r *sessionRepository) Create(ctx context.Context, session *domain.Session) error {
+	_, err := r.collection.InsertOne(ctx, session)
+	return err
+}
+
+// ListActiveByUserID 사용자의 활성 세션 조회 (최근 활동순)
+func (r *sessionRepository) ListActiveByUserID(ctx context.Context, userID string, now time.Time) ([]*domain.Session, error) {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cur, err := r.collection.Find(
+		ctx,
+		activeSession(objectID, now),
+		options.Find().SetSort(bson.D{{Key: "last_seen_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	sessions := make([]*domain.Session, 0)
+	if err := cur.All(ctx, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// FindActive 사용자의 활성 세션 단건 조회
+func (r *sessionRepository) FindActive(ctx context.Context, userID, sessionID string, now time.Time) (*domain.Session, error) {
+	filter, err := sessionFilter(userID, sessionID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var session domain.Session
+	err = r.collection.FindOne(ctx, filter).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return nil, domain.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Touch 마지막 활동 시각 갱신 (최근에 갱신된 세션은 조건에 맞지 않아 쓰기가 일어나지 않음)
+func (r *sessionRepository) Touch(ctx context.Context, sessionID string, at time.Time, minInterval time.Duration) error {
+	objectID, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return domain.ErrSessionNotFound
+	}
+
+	_, err = r.collection.UpdateOne(
+		ctx,
+		bson.M{
+			"_id":          objectID,
+			"revoked_at":   nil,
+			"last_seen_at": bson.M{"$lt": at.Add(-minInterval)},
+		},
+		bson.M{"$set": bson.M{"last_seen_at": at}},
+	)
+	return err
+}
+
+// Revoke 세션 폐기 처리
+func (r *sessionRepository) Revoke(ctx context.Context, userID, sessionID string, at time.Time) error {
+	filter, err := sessionFilter(userID, sessionID, at)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"revoked_at": at}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeAllByUserID 사용자의 모든 활성 세션 폐기 처리
+func (r *sessionRepository) RevokeAllByUserID(ctx context.Context, userID string, at time.Time) error {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.UpdateMany(ctx, activeSession(objectID, at), bson.M{"$set": bson.M{"revoked_at": at}})
+	return err
+}
+
+// DeleteByUserID 사용자의 세션 전체 삭제
+func (r *sessionRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.DeleteMany(ctx, bson.M{"user_id": objectID})
+	return err
+}
+
+// sessionFilter 사용자 소유의 활성 세션 조건 (형식이 잘못된 ID는 존재하지 않는 세션으로 취급)
+func sessionFilter(userID, sessionID string, now time.Time) (bson.M, error) {
+	userObjectID, err := toObjectID(userID)
+	if err != nil {
+		return nil, err
+	}
+	sessionObjectID, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return nil, domain.ErrSessionNotFound
+	}
+
+	filter := activeSession(userObjectID, now)
+	filter["_id"] = sessionObjectID
+	return filter, nil
+}
@@ -0,0 +1,134 @@
+// quser/internal/repository/mongodb/user_list.go
+package mongodb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/signalable/quser/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// listCursor 페이지 커서 (마지막 문서의 정렬 키)
+type listCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// List 필터 조건에 맞는 사용자 목록을 created_at, _id 기준 keyset 방식으로 조회
+func (r *userRepository) List(ctx context.Context, filter domain.UserFilter, page domain.Page) (*domain.UserPage, error) {
+	query := buildUserFilter(filter)
+
+	// 커서 이후 문서만 조회
+	if page.Cursor != "" {
+		cursor, err := decodeListCursor(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		objectID, err := primitive.ObjectIDFromHex(cursor.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+
+		op := "$gt"
+		if page.Descending {
+			op = "$lt"
+		}
+		query = bson.M{"$and": bson.A{
+			query,
+			bson.M{"$or": bson.A{
+				bson.M{"created_at": bson.M{op: cursor.CreatedAt}},
+				bson.M{"created_at": cursor.CreatedAt, "_id": bson.M{op: objectID}},
+			}},
+		}}
+	}
+
+	direction := 1
+	if page.Descending {
+		direction = -1
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(page.Limit + 1))
+
+	cur, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	users := make([]*domain.User, 0, page.Limit+1)
+	if err := cur.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	result := &domain.UserPage{Users: users}
+	if len(users) > page.Limit {
+		result.Users = users[:page.Limit]
+		last := result.Users[len(result.Users)-1]
+		result.NextCursor = encodeListCursor(listCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID.Hex(),
+		})
+	}
+
+	return result, nil
+}
+
+func buildUserFilter(filter domain.UserFilter) bson.M {
+	// 상태를 명시하지 않으면 삭제된 사용자는 제외
+	query := bson.M{"status": notDeleted}
+
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.IsVerified != nil {
+		query["is_verified"] = *filter.IsVerified
+	}
+
+	createdAt := bson.M{}
+	if filter.CreatedAfter != nil {
+		createdAt["$gte"] = *filter.CreatedAfter
+	}
+	if filter.CreatedBefore != nil {
+		createdAt["$lt"] = *filter.CreatedBefore
+	}
+	if len(createdAt) > 0 {
+		query["created_at"] = createdAt
+	}
+
+	if filter.EmailPrefix != "" {
+		// 정규화된 접두어를 대소문자 구분 고정 접두어로 검색하여 email_normalized 인덱스 범위 탐색 사용
+		query["email_normalized"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.EmailPrefix)}
+	}
+	if filter.NamePrefix != "" {
+		query["name"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.NamePrefix), Options: "i"}
+	}
+
+	return query
+}
+
+func encodeListCursor(cursor listCursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeListCursor(value string) (*listCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	var cursor listCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
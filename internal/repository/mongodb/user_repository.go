@@ -3,28 +3,35 @@ package mongodb
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/signalable/quser/internal/domain"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// notDeleted 삭제된 사용자를 제외하는 기본 조회 조건
+var notDeleted = bson.M{"$ne": domain.UserStatusDeleted}
+
 type userRepository struct {
 	db         *mongo.Database
 	collection *mongo.Collection
+	logger     *slog.Logger
 }
 
 // NewUserRepository MongoDB 유저 레포지토리 생성자
-func NewUserRepository(db *mongo.Database) *userRepository {
+func NewUserRepository(db *mongo.Database, logger *slog.Logger) *userRepository {
 	return &userRepository{
 		db:         db,
-		collection: db.Collection("users"),
+		collection: db.Collection(usersCollection),
+		logger:     logger.With("component", "user_repository"),
 	}
 }
 
-// Create 새로운 사용자 생성
+// Create 새로운 사용자 생성 (이메일 중복은 unique 인덱스로 보장)
 func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 	user.CreatedAt = time.Now()
 	user.UpdatedAt = time.Now()
@@ -32,6 +39,11 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 
 	result, err := r.collection.InsertOne(ctx, user)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			r.logger.DebugContext(ctx, "이메일 unique 인덱스 충돌", "email", user.Email)
+			return domain.ErrEmailAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "사용자 생성 실패", "error", err)
 		return err
 	}
 
@@ -39,10 +51,10 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
-// FindByEmail 이메일로 사용자 찾기
-func (r *userRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+// FindByEmail 정규화된 이메일로 사용자 찾기
+func (r *userRepository) FindByEmail(ctx context.Context, normalizedEmail string) (*domain.User, error) {
 	var user domain.User
-	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&user)
+	err := r.collection.FindOne(ctx, bson.M{"email_normalized": normalizedEmail, "status": notDeleted}).Decode(&user)
 	if err == mongo.ErrNoDocuments {
 		return nil, domain.ErrUserNotFound
 	}
@@ -51,22 +63,22 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*domain
 
 // FindByID ID로 사용자 찾기
 func (r *userRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
-	objectID, err := primitive.ObjectIDFromHex(id)
+	objectID, err := toObjectID(id)
 	if err != nil {
 		return nil, err
 	}
 
 	var user domain.User
-	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&user)
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID, "status": notDeleted}).Decode(&user)
 	if err == mongo.ErrNoDocuments {
 		return nil, domain.ErrUserNotFound
 	}
 	return &user, err
 }
 
-// ExistsByEmail 이메일 존재 여부 확인
-func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{"email": email})
+// ExistsByEmail 이메일 존재 여부 확인 (복구 가능한 삭제 계정도 이메일을 점유하므로 포함)
+func (r *userRepository) ExistsByEmail(ctx context.Context, normalizedEmail string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"email_normalized": normalizedEmail})
 	if err != nil {
 		return false, err
 	}
@@ -87,27 +99,129 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 
 // UpdateProfile 프로필 업데이트
 func (r *userRepository) UpdateProfile(ctx context.Context, userID string, profile *domain.UserProfile) error {
-	objectID, err := primitive.ObjectIDFromHex(userID)
+	return r.updateNotDeleted(ctx, userID, bson.M{
+		"$set": bson.M{
+			"profile":    profile,
+			"updated_at": time.Now(),
+		},
+	})
+}
+
+// UpdateVerificationStatus 이메일 인증 상태 업데이트
+func (r *userRepository) UpdateVerificationStatus(ctx context.Context, userID string, isVerified bool) error {
+	return r.updateNotDeleted(ctx, userID, bson.M{
+		"$set": bson.M{
+			"is_verified": isVerified,
+			"status":      domain.UserStatusActive,
+			"updated_at":  time.Now(),
+		},
+	})
+}
+
+// UpdateStatus 사용자 상태 업데이트
+func (r *userRepository) UpdateStatus(ctx context.Context, userID string, status string) error {
+	return r.updateNotDeleted(ctx, userID, bson.M{
+		"$set": bson.M{
+			"status":     status,
+			"updated_at": time.Now(),
+		},
+	})
+}
+
+// UpdatePassword 비밀번호 해시 업데이트
+func (r *userRepository) UpdatePassword(ctx context.Context, userID string, passwordHash string) error {
+	return r.updateNotDeleted(ctx, userID, bson.M{
+		"$set": bson.M{
+			"password":   passwordHash,
+			"updated_at": time.Now(),
+		},
+	})
+}
+
+// UpdateLastLogin 마지막 로그인 시각 갱신
+func (r *userRepository) UpdateLastLogin(ctx context.Context, userID string, at time.Time) error {
+	return r.updateNotDeleted(ctx, userID, bson.M{"$set": bson.M{"profile.last_login": at}})
+}
+
+// updateNotDeleted 삭제되지 않은 사용자만 갱신 (소프트 삭제된 계정이 남은 토큰 등으로 변경·복구되지 않도록)
+func (r *userRepository) updateNotDeleted(ctx context.Context, userID string, update bson.M) error {
+	objectID, err := toObjectID(userID)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.collection.UpdateOne(
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID, "status": notDeleted}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+// RecordFailedLogin 로그인 실패를 기록하고 정책에 따라 잠금 시간을 계산 (파이프라인 업데이트로 원자적 처리)
+func (r *userRepository) RecordFailedLogin(ctx context.Context, userID string, at time.Time, policy domain.LockoutPolicy) (*domain.User, error) {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 마지막 실패가 ResetAfter 이전이면 횟수를 새로 셈
+	count := bson.M{"$cond": bson.A{
+		bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$last_failed_login_at", time.Time{}}}, at.Add(-policy.ResetAfter)}},
+		1,
+		bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$failed_login_count", 0}}, 1}},
+	}}
+
+	// 잠금 시간 = min(BaseDuration * 2^(실패 횟수 - Threshold), MaxDuration)
+	lockMillis := bson.M{"$min": bson.A{
+		policy.MaxDuration.Milliseconds(),
+		bson.M{"$multiply": bson.A{
+			policy.BaseDuration.Milliseconds(),
+			bson.M{"$pow": bson.A{2, bson.M{"$subtract": bson.A{"$failed_login_count", policy.Threshold}}}},
+		}},
+	}}
+
+	var user domain.User
+	err = r.collection.FindOneAndUpdate(
 		ctx,
 		bson.M{"_id": objectID},
-		bson.M{
-			"$set": bson.M{
-				"profile":    profile,
-				"updated_at": time.Now(),
-			},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"failed_login_count":   count,
+				"last_failed_login_at": at,
+			}}},
+			{{Key: "$set", Value: bson.M{
+				"locked_until": bson.M{"$cond": bson.A{
+					bson.M{"$gte": bson.A{"$failed_login_count", policy.Threshold}},
+					bson.M{"$add": bson.A{at, lockMillis}},
+					"$locked_until",
+				}},
+			}}},
 		},
-	)
-	return err
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsLocked(at) {
+		r.logger.InfoContext(ctx, "로그인 실패 반복으로 계정 잠금",
+			"user_id", userID,
+			"failed_count", user.FailedLoginCount,
+			"locked_until", user.LockedUntil,
+		)
+	}
+	return &user, nil
 }
 
-// UpdateVerificationStatus 이메일 인증 상태 업데이트
-func (r *userRepository) UpdateVerificationStatus(ctx context.Context, userID string, isVerified bool) error {
-	objectID, err := primitive.ObjectIDFromHex(userID)
+// ResetFailedLogins 로그인 실패 횟수와 잠금 초기화 (마지막 실패 시각은 기록으로 유지)
+func (r *userRepository) ResetFailedLogins(ctx context.Context, userID string) error {
+	objectID, err := toObjectID(userID)
 	if err != nil {
 		return err
 	}
@@ -115,33 +229,117 @@ func (r *userRepository) UpdateVerificationStatus(ctx context.Context, userID st
 	_, err = r.collection.UpdateOne(
 		ctx,
 		bson.M{"_id": objectID},
-		bson.M{
-			"$set": bson.M{
-				"is_verified": isVerified,
-				"status":      domain.UserStatusActive,
-				"updated_at":  time.Now(),
-			},
-		},
+		bson.M{"$unset": bson.M{"failed_login_count": "", "locked_until": ""}},
 	)
 	return err
 }
 
-// UpdateStatus 사용자 상태 업데이트
-func (r *userRepository) UpdateStatus(ctx context.Context, userID string, status string) error {
-	objectID, err := primitive.ObjectIDFromHex(userID)
+// SoftDelete 사용자 소프트 삭제 (이전 상태를 보관)
+func (r *userRepository) SoftDelete(ctx context.Context, userID string, deletedAt time.Time) error {
+	objectID, err := toObjectID(userID)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.collection.UpdateOne(
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": objectID, "status": notDeleted},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"previous_status": "$status",
+				"status":          domain.UserStatusDeleted,
+				"deleted_at":      deletedAt,
+				"updated_at":      time.Now(),
+			}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	r.logger.InfoContext(ctx, "사용자 소프트 삭제", "user_id", userID)
+	return nil
+}
+
+// Restore 소프트 삭제된 사용자 복구
+func (r *userRepository) Restore(ctx context.Context, userID string, deletedAfter time.Time) error {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.collection.UpdateOne(
 		ctx,
-		bson.M{"_id": objectID},
 		bson.M{
-			"$set": bson.M{
-				"status":     status,
+			"_id":        objectID,
+			"status":     domain.UserStatusDeleted,
+			"deleted_at": bson.M{"$gt": deletedAfter},
+		},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"status":     bson.M{"$ifNull": bson.A{"$previous_status", domain.UserStatusPending}},
 				"updated_at": time.Now(),
-			},
+			}}},
+			{{Key: "$unset", Value: bson.A{"previous_status", "deleted_at"}}},
 		},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrRestoreNotAllowed
+	}
+
+	r.logger.InfoContext(ctx, "사용자 복구", "user_id", userID)
+	return nil
+}
+
+// FindDeletedBefore 특정 시각 이전에 소프트 삭제된 사용자 조회
+func (r *userRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	cur, err := r.collection.Find(
+		ctx,
+		bson.M{
+			"status":     domain.UserStatusDeleted,
+			"deleted_at": bson.M{"$lte": before},
+		},
+		options.Find().SetSort(bson.D{{Key: "deleted_at", Value: 1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var users []*domain.User
+	if err := cur.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Delete 사용자 영구 삭제
+func (r *userRepository) Delete(ctx context.Context, userID string) error {
+	objectID, err := toObjectID(userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID}); err != nil {
+		r.logger.ErrorContext(ctx, "사용자 영구 삭제 실패", "user_id", userID, "error", err)
+		return err
+	}
+
+	r.logger.InfoContext(ctx, "사용자 영구 삭제", "user_id", userID)
+	return nil
+}
+
+// toObjectID 사용자 ID 변환 (형식이 잘못된 ID는 존재하지 않는 사용자로 취급)
+func toObjectID(id string) (primitive.ObjectID, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return primitive.NilObjectID, domain.ErrUserNotFound
+	}
+	return objectID, nil
 }
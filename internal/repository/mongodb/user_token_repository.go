@@ -0,0 +1,113 @@
+// quser/internal/repository/mongodb/user_token_repository.go
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/signalable/quser/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 컬렉션 이름
+const (
+	emailVerificationTokenCollection = "email_verification_tokens"
+	passwordResetTokenCollection     = "password_reset_tokens"
+)
+
+type userTokenRepository struct {
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+// NewEmailVerificationTokenRepository 이메일 인증 토큰 레포지토리 생성자
+func NewEmailVerificationTokenRepository(db *mongo.Database) *userTokenRepository {
+	return newUserTokenRepository(db, emailVerificationTokenCollection)
+}
+
+// NewPasswordResetTokenRepository 비밀번호 재설정 토큰 레포지토리 생성자
+func NewPasswordResetTokenRepository(db *mongo.Database) *userTokenRepository {
+	return newUserTokenRepository(db, passwordResetTokenCollection)
+}
+
+func newUserTokenRepository(db *mongo.Database, collection string) *userTokenRepository {
+	return &userTokenRepository{
+		db:         db,
+		collection: db.Collection(collection),
+	}
+}
+
+// Create 토큰 저장
+func (r *userTokenRepository) Create(ctx context.Context, token *domain.UserToken) error {
+	token.CreatedAt = time.Now()
+
+	result, err := r.collection.InsertOne(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	token.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// Consume 만료되지 않은 미사용 토큰을 원자적으로 사용 처리
+func (r *userTokenRepository) Consume(ctx context.Context, tokenHash string) (*domain.UserToken, error) {
+	return r.consume(ctx, bson.M{"token_hash": tokenHash})
+}
+
+// ConsumeForUser 지정한 사용자의 토큰일 때만 사용 처리 (다른 사용자의 토큰은 사용 처리하지 않음)
+func (r *userTokenRepository) ConsumeForUser(ctx context.Context, userID, tokenHash string) (*domain.UserToken, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.ErrInvalidToken
+	}
+	return r.consume(ctx, bson.M{"token_hash": tokenHash, "user_id": objectID})
+}
+
+func (r *userTokenRepository) consume(ctx context.Context, filter bson.M) (*domain.UserToken, error) {
+	now := time.Now()
+	filter["used_at"] = nil
+	filter["expires_at"] = bson.M{"$gt": now}
+
+	var token domain.UserToken
+	err := r.collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		bson.M{"$set": bson.M{"used_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&token)
+	if err == mongo.ErrNoDocuments {
+		return nil, domain.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CountCreatedSince 특정 시각 이후 발급된 토큰 수 조회
+func (r *userTokenRepository) CountCreatedSince(ctx context.Context, userID string, since time.Time) (int64, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.collection.CountDocuments(ctx, bson.M{
+		"user_id":    objectID,
+		"created_at": bson.M{"$gte": since},
+	})
+}
+
+// DeleteByUserID 사용자의 토큰 전체 삭제
+func (r *userTokenRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.DeleteMany(ctx, bson.M{"user_id": objectID})
+	return err
+}
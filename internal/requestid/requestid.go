@@ -0,0 +1,54 @@
+// quser/internal/requestid/requestid.go
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header 요청 ID를 주고받는 HTTP 헤더
+const Header = "X-Request-ID"
+
+// maxLength 클라이언트가 보낸 요청 ID의 최대 길이
+const maxLength = 128
+
+type contextKey struct{}
+
+// NewContext 요청 ID를 담은 컨텍스트 반환
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext 컨텍스트의 요청 ID (없으면 빈 문자열)
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Generate 새 요청 ID 생성 (128비트 난수의 hex 문자열)
+func Generate() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// Valid 클라이언트가 보낸 요청 ID를 그대로 사용할 수 있는지 확인
+//
+// 로그와 헤더에 그대로 기록되므로 길이를 제한하고 영숫자와 일부 기호만 허용한다.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
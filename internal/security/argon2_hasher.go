@@ -0,0 +1,147 @@
+// quser/internal/security/argon2_hasher.go
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+var (
+	// ErrInvalidHash 해시 형식이 올바르지 않을 때 반환
+	ErrInvalidHash = errors.New("잘못된 비밀번호 해시 형식입니다")
+	// ErrIncompatibleVersion 지원하지 않는 argon2 버전일 때 반환
+	ErrIncompatibleVersion = errors.New("지원하지 않는 argon2 버전입니다")
+)
+
+// Argon2Params argon2id 해시 파라미터
+type Argon2Params struct {
+	Memory      uint32 // KiB 단위
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params 기본 argon2id 파라미터 (OWASP 권장값 기준)
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type argon2Hasher struct {
+	params Argon2Params
+}
+
+// NewArgon2Hasher argon2id 비밀번호 해시 생성자
+func NewArgon2Hasher(params Argon2Params) PasswordHasher {
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultArgon2Params.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultArgon2Params.KeyLength
+	}
+	return &argon2Hasher{params: params}
+}
+
+// Hash PHC 형식($argon2id$v=19$m=...,t=...,p=...$salt$hash)으로 해시 생성
+func (h *argon2Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("salt 생성 실패: %w", err)
+	}
+
+	key := argon2.IDKey(
+		[]byte(password),
+		salt,
+		h.params.Iterations,
+		h.params.Memory,
+		h.params.Parallelism,
+		h.params.KeyLength,
+	)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify 비밀번호 검증 (상수 시간 비교)
+func (h *argon2Hasher) Verify(password, encodedHash string) (bool, error) {
+	params, salt, key, err := decodeArgon2Hash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	otherKey := argon2.IDKey(
+		[]byte(password),
+		salt,
+		params.Iterations,
+		params.Memory,
+		params.Parallelism,
+		params.KeyLength,
+	)
+
+	return subtle.ConstantTimeCompare(key, otherKey) == 1, nil
+}
+
+// NeedsRehash 저장된 해시의 파라미터가 현재 설정과 다른지 확인
+func (h *argon2Hasher) NeedsRehash(encodedHash string) bool {
+	params, salt, _, err := decodeArgon2Hash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		uint32(len(salt)) != h.params.SaltLength
+}
+
+func decodeArgon2Hash(encodedHash string) (*Argon2Params, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, ErrIncompatibleVersion
+	}
+
+	params := &Argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	params.SaltLength = uint32(len(salt))
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,12 @@
+// quser/internal/security/interfaces.go
+package security
+
+// PasswordHasher 비밀번호 해시 인터페이스 정의
+type PasswordHasher interface {
+	// 비밀번호 해시 생성
+	Hash(password string) (string, error)
+	// 비밀번호와 해시 일치 여부 확인
+	Verify(password, encodedHash string) (bool, error)
+	// 현재 파라미터로 재해시가 필요한지 확인
+	NeedsRehash(encodedHash string) bool
+}
@@ -0,0 +1,29 @@
+// quser/internal/security/token.go
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+const tokenByteLength = 32
+
+// GenerateToken 일회용 토큰 생성 (원본 토큰과 저장용 해시 반환)
+func GenerateToken() (string, string, error) {
+	b := make([]byte, tokenByteLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("토큰 생성 실패: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken 토큰의 SHA-256 해시 (hex)
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,184 @@
+// quser/internal/tokenverify/denylist.go
+package tokenverify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 폐기 항목 유형
+const (
+	revocationToken   = "token"   // 개별 토큰 (jti)
+	revocationSession = "session" // 세션에 발급된 모든 토큰 (sid)
+	revocationUser    = "user"    // 폐기 시각 이전에 발급된 사용자의 모든 토큰 (sub)
+)
+
+// revocation Auth 서비스 폐기 목록 항목
+type revocation struct {
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	RevokedAt int64  `json:"revoked_at"` // Unix 초 (user 유형은 이 시각보다 앞선 초에 발급된 토큰이 대상)
+	ExpiresAt int64  `json:"expires_at"` // 이 시각 이후에는 대상 토큰이 모두 만료되므로 항목 삭제
+}
+
+// denylist Auth 서비스 폐기 목록의 로컬 사본
+//
+// 마지막 동기화 시각 이후의 변경분만 받아 병합하고, 만료된 항목은 동기화 때 정리한다.
+type denylist struct {
+	url        string
+	httpClient *http.Client
+	leeway     time.Duration // 만료 직후에도 허용 오차만큼은 토큰이 통과하므로 항목을 더 보관
+	maxStale   time.Duration // 마지막 동기화 후 이 시간이 지나면 사본을 신뢰하지 않음
+
+	mu       sync.RWMutex
+	syncedAt time.Time             // 마지막 동기화 성공 시각 (0이면 한 번도 받지 못함)
+	cursor   int64                 // 다음 동기화 때 since로 보낼 서버 시각
+	tokens   map[string]int64      // jti → 만료 시각
+	sessions map[string]int64      // sid → 만료 시각
+	users    map[string]revocation // sub → 가장 최근 일괄 폐기
+}
+
+func newDenylist(url string, httpClient *http.Client, leeway, maxStale time.Duration) *denylist {
+	return &denylist{
+		url:        url,
+		httpClient: httpClient,
+		leeway:     leeway,
+		maxStale:   maxStale,
+		tokens:     make(map[string]int64),
+		sessions:   make(map[string]int64),
+		users:      make(map[string]revocation),
+	}
+}
+
+// isRevoked 토큰 폐기 여부 확인 (사본이 없거나 오래되었으면 폐기 여부를 알 수 없으므로 에러)
+func (d *denylist) isRevoked(claims *accessClaims, now time.Time) (bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if err := d.freshLocked(now); err != nil {
+		return false, err
+	}
+
+	if _, ok := d.tokens[claims.ID]; ok && claims.ID != "" {
+		return true, nil
+	}
+	if _, ok := d.sessions[claims.SessionID]; ok && claims.SessionID != "" {
+		return true, nil
+	}
+	if entry, ok := d.users[claims.Subject]; ok {
+		// 발급 시각을 모르면 폐기된 것으로 간주
+		//
+		// iat와 폐기 시각 모두 초 단위이므로 같은 초에 발급된 토큰은 폐기 이후 발급으로 본다.
+		// 비밀번호 재설정·계정 복구 직후 같은 초에 다시 로그인한 토큰이 만료 때까지 거부되지 않도록 하기 위함이며,
+		// 폐기 직전 같은 초에 발급된 토큰이 통과할 수 있는 1초 미만의 오차는 허용한다.
+		if claims.IssuedAt == nil || claims.IssuedAt.Unix() < entry.RevokedAt {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fresh 폐기 목록 사본을 신뢰할 수 있는지 확인
+func (d *denylist) fresh(now time.Time) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.freshLocked(now)
+}
+
+func (d *denylist) freshLocked(now time.Time) error {
+	if d.syncedAt.IsZero() {
+		return ErrDenylistNotSynced
+	}
+	if d.maxStale > 0 && now.Sub(d.syncedAt) > d.maxStale {
+		return fmt.Errorf("%w (마지막 동기화: %s)", ErrDenylistStale, d.syncedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// sync 마지막 동기화 이후의 폐기 항목을 받아 병합
+func (d *denylist) sync(ctx context.Context) (int, error) {
+	d.mu.RLock()
+	cursor := d.cursor
+	d.mu.RUnlock()
+
+	u, err := url.Parse(d.url)
+	if err != nil {
+		return 0, fmt.Errorf("잘못된 폐기 목록 URL: %w", err)
+	}
+	query := u.Query()
+	query.Set("since", strconv.FormatInt(cursor, 10))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("폐기 목록 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("폐기 목록 조회 실패: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Entries  []revocation `json:"entries"`
+		SyncedAt int64        `json:"synced_at"` // 서버 기준 조회 시각 (다음 since 값)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("폐기 목록 파싱 실패: %w", err)
+	}
+
+	d.merge(body.Entries, body.SyncedAt, time.Now())
+	return len(body.Entries), nil
+}
+
+func (d *denylist) merge(entries []revocation, syncedAt int64, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, entry := range entries {
+		switch entry.Type {
+		case revocationToken:
+			d.tokens[entry.Value] = entry.ExpiresAt
+		case revocationSession:
+			d.sessions[entry.Value] = entry.ExpiresAt
+		case revocationUser:
+			if prev, ok := d.users[entry.Value]; !ok || entry.RevokedAt >= prev.RevokedAt {
+				d.users[entry.Value] = entry
+			}
+		}
+	}
+
+	// 대상 토큰이 모두 만료된 항목 정리
+	cutoff := now.Add(-d.leeway).Unix()
+	for jti, expiresAt := range d.tokens {
+		if expiresAt > 0 && expiresAt < cutoff {
+			delete(d.tokens, jti)
+		}
+	}
+	for sid, expiresAt := range d.sessions {
+		if expiresAt > 0 && expiresAt < cutoff {
+			delete(d.sessions, sid)
+		}
+	}
+	for sub, entry := range d.users {
+		if entry.ExpiresAt > 0 && entry.ExpiresAt < cutoff {
+			delete(d.users, sub)
+		}
+	}
+
+	if syncedAt > d.cursor {
+		d.cursor = syncedAt
+	}
+	d.syncedAt = now
+}
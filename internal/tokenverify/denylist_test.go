@@ -0,0 +1,150 @@
+package tokenverify
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/signalable/quser/internal/client"
+)
+
+func TestDenylistIsRevoked(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	revokedAt := now.Add(-time.Minute)
+	expiresAt := now.Add(time.Hour).Unix()
+
+	d := newDenylist("", nil, 0, time.Minute)
+	d.merge([]revocation{
+		{Type: revocationToken, Value: "jti-revoked", RevokedAt: revokedAt.Unix(), ExpiresAt: expiresAt},
+		{Type: revocationSession, Value: "sid-revoked", RevokedAt: revokedAt.Unix(), ExpiresAt: expiresAt},
+		{Type: revocationUser, Value: "user-revoked", RevokedAt: revokedAt.Unix(), ExpiresAt: expiresAt},
+	}, now.Unix(), now)
+
+	tests := []struct {
+		name   string
+		claims accessClaims
+		want   bool
+	}{
+		{"not revoked", claimsFor("user", "jti", "sid", now), false},
+		{"token revoked", claimsFor("user", "jti-revoked", "sid", now), true},
+		{"session revoked", claimsFor("user", "jti", "sid-revoked", now), true},
+		{"user revoked, issued before", claimsFor("user-revoked", "jti", "sid", revokedAt.Add(-time.Second)), true},
+		{"user revoked, issued at revocation", claimsFor("user-revoked", "jti", "sid", revokedAt), false},
+		{"user revoked, issued later in the same second", claimsFor("user-revoked", "jti", "sid", revokedAt.Add(900*time.Millisecond)), false},
+		{"user revoked, issued after", claimsFor("user-revoked", "jti", "sid", revokedAt.Add(time.Second)), false},
+		{"user revoked, no iat", accessClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-revoked"}}, true},
+		{"empty jti and sid", claimsFor("user", "", "", now), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.isRevoked(&tt.claims, now)
+			if err != nil {
+				t.Fatalf("isRevoked() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("isRevoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDenylistFreshness(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	claims := claimsFor("user", "jti", "sid", now)
+
+	tests := []struct {
+		name     string
+		syncedAt time.Time // 0이면 동기화하지 않음
+		maxStale time.Duration
+		wantErr  error
+	}{
+		{"never synced", time.Time{}, time.Minute, ErrDenylistNotSynced},
+		{"recently synced", now.Add(-30 * time.Second), time.Minute, nil},
+		{"stale", now.Add(-2 * time.Minute), time.Minute, ErrDenylistStale},
+		{"staleness unchecked", now.Add(-24 * time.Hour), 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDenylist("", nil, 0, tt.maxStale)
+			if !tt.syncedAt.IsZero() {
+				d.merge(nil, tt.syncedAt.Unix(), tt.syncedAt)
+			}
+
+			_, err := d.isRevoked(&claims, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("isRevoked() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && !client.IsUnavailable(err) {
+				t.Errorf("isRevoked() error = %v, want auth service unavailable", err)
+			}
+		})
+	}
+}
+
+func TestDenylistMergePrunesExpiredEntries(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	leeway := 30 * time.Second
+
+	d := newDenylist("", nil, leeway, 0)
+	d.merge([]revocation{
+		{Type: revocationToken, Value: "expired", ExpiresAt: now.Add(-time.Minute).Unix()},
+		{Type: revocationToken, Value: "within-leeway", ExpiresAt: now.Add(-10 * time.Second).Unix()},
+		{Type: revocationToken, Value: "no-expiry"},
+		{Type: revocationSession, Value: "expired", ExpiresAt: now.Add(-time.Minute).Unix()},
+		{Type: revocationSession, Value: "active", ExpiresAt: now.Add(time.Hour).Unix()},
+		{Type: revocationUser, Value: "expired", ExpiresAt: now.Add(-time.Minute).Unix()},
+		{Type: revocationUser, Value: "within-leeway", ExpiresAt: now.Add(-10 * time.Second).Unix()},
+	}, now.Unix(), now)
+
+	assertKeys(t, "tokens", d.tokens, "within-leeway", "no-expiry")
+	assertKeys(t, "sessions", d.sessions, "active")
+	assertKeys(t, "users", d.users, "within-leeway")
+	if d.cursor != now.Unix() {
+		t.Errorf("cursor = %d, want %d", d.cursor, now.Unix())
+	}
+}
+
+func TestDenylistMergeKeepsLatestUserRevocation(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	expiresAt := now.Add(time.Hour).Unix()
+
+	d := newDenylist("", nil, 0, 0)
+	d.merge([]revocation{{Type: revocationUser, Value: "user", RevokedAt: now.Unix(), ExpiresAt: expiresAt}}, now.Unix(), now)
+	// 이전 커서로 다시 받은 오래된 항목이 최신 폐기를 덮어쓰지 않아야 함
+	d.merge([]revocation{{Type: revocationUser, Value: "user", RevokedAt: now.Add(-time.Hour).Unix(), ExpiresAt: expiresAt}}, now.Add(-time.Hour).Unix(), now)
+
+	if got := d.users["user"].RevokedAt; got != now.Unix() {
+		t.Errorf("RevokedAt = %d, want %d", got, now.Unix())
+	}
+	if d.cursor != now.Unix() {
+		t.Errorf("cursor moved backwards to %d", d.cursor)
+	}
+}
+
+func claimsFor(sub, jti, sid string, issuedAt time.Time) accessClaims {
+	return accessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  sub,
+			ID:       jti,
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+		SessionID: sid,
+	}
+}
+
+func assertKeys[V any](t *testing.T, name string, m map[string]V, want ...string) {
+	t.Helper()
+	if len(m) != len(want) {
+		t.Errorf("%s = %v, want keys %v", name, m, want)
+		return
+	}
+	for _, key := range want {
+		if _, ok := m[key]; !ok {
+			t.Errorf("%s missing key %q", name, key)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// quser/internal/tokenverify/jwks.go
+package tokenverify
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/signalable/quser/internal/client"
+)
+
+// 알 수 없는 kid로 인한 JWKS 재조회 제한 (위조 토큰으로 Auth 서비스를 두드리지 않도록)
+//
+// 같은 kid는 minRefetchInterval 동안 한 번만 다시 받고, kid와 관계없이 minFetchGap 안에는 다시 받지 않는다.
+// kid별로 제한하므로 임의의 kid를 가진 위조 토큰이 새로 교체된 키의 조회를 오래 막지 못한다.
+const (
+	minRefetchInterval = time.Minute
+	minFetchGap        = 5 * time.Second
+	maxTrackedMisses   = 1024
+)
+
+var errKeyNotFound = errors.New("서명 키를 찾을 수 없습니다")
+
+// jsonWebKey JWKS 응답의 개별 키 (RFC 7517)
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// verificationKey 파싱된 공개 키와 허용 알고리즘
+type verificationKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// keySet Auth 서비스 JWKS 캐시
+//
+// 주기적으로 전체를 다시 받아 키 교체를 반영하고, 캐시에 없는 kid가 들어오면
+// 새 키가 추가되었을 수 있으므로 즉시 다시 받는다.
+type keySet struct {
+	url        string
+	httpClient *http.Client
+	logger     *slog.Logger
+
+	mu   sync.RWMutex
+	keys map[string]verificationKey
+
+	fetchMu   sync.Mutex
+	lastFetch time.Time            // 마지막 JWKS 조회 시각
+	misses    map[string]time.Time // kid → 해당 kid 때문에 마지막으로 재조회한 시각
+}
+
+func newKeySet(url string, httpClient *http.Client, logger *slog.Logger) *keySet {
+	return &keySet{
+		url:        url,
+		httpClient: httpClient,
+		logger:     logger,
+		keys:       make(map[string]verificationKey),
+		misses:     make(map[string]time.Time),
+	}
+}
+
+// key kid에 해당하는 검증 키 조회 (캐시에 없으면 JWKS를 다시 받아 확인)
+func (s *keySet) key(ctx context.Context, kid string) (verificationKey, error) {
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+
+	// 대기 중 다른 요청이 이미 다시 받았을 수 있음
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+	now := time.Now()
+	if !s.allowMissFetch(kid, now) {
+		return verificationKey{}, s.missingKeyError()
+	}
+	if err := s.refreshLocked(ctx); err != nil {
+		return verificationKey{}, fmt.Errorf("%w: %w", client.ErrUnavailable, err)
+	}
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+	return verificationKey{}, errKeyNotFound
+}
+
+// allowMissFetch 알 수 없는 kid로 재조회해도 되는지 확인하고 기록 (fetchMu 보유 상태에서 호출)
+func (s *keySet) allowMissFetch(kid string, now time.Time) bool {
+	if now.Sub(s.lastFetch) < minFetchGap {
+		return false
+	}
+	if last, ok := s.misses[kid]; ok && now.Sub(last) < minRefetchInterval {
+		return false
+	}
+
+	// 기록이 너무 많아지면 제한 시간이 지난 항목부터 정리 (그래도 넘치면 초기화하고 minFetchGap에 맡김)
+	if len(s.misses) >= maxTrackedMisses {
+		for k, last := range s.misses {
+			if now.Sub(last) >= minRefetchInterval {
+				delete(s.misses, k)
+			}
+		}
+		if len(s.misses) >= maxTrackedMisses {
+			clear(s.misses)
+		}
+	}
+	s.misses[kid] = now
+	return true
+}
+
+// missingKeyError 키를 찾지 못한 원인 (한 번도 받지 못했다면 토큰 문제가 아니라 Auth 서비스 장애)
+func (s *keySet) missingKeyError() error {
+	if !s.loaded() {
+		return fmt.Errorf("%w: JWKS를 아직 받지 못했습니다", client.ErrUnavailable)
+	}
+	return errKeyNotFound
+}
+
+// loaded 사용 가능한 서명 키를 한 번이라도 받았는지 여부
+func (s *keySet) loaded() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.keys) > 0
+}
+
+// lookup 캐시 조회 (kid가 없는 토큰은 키가 하나뿐일 때만 허용)
+func (s *keySet) lookup(kid string) (verificationKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if kid == "" {
+		if len(s.keys) != 1 {
+			return verificationKey{}, false
+		}
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// refresh JWKS 전체 재조회
+func (s *keySet) refresh(ctx context.Context) error {
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+	return s.refreshLocked(ctx)
+}
+
+func (s *keySet) refreshLocked(ctx context.Context) error {
+	s.lastFetch = time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", s.url, nil)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("JWKS 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("JWKS 조회 실패: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("JWKS 파싱 실패: %w", err)
+	}
+
+	keys := make(map[string]verificationKey, len(body.Keys))
+	for _, jwk := range body.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseKey(jwk)
+		if err != nil {
+			// 지원하지 않는 키가 섞여 있어도 나머지 키로 검증
+			s.logger.WarnContext(ctx, "JWKS 키 무시", "kid", jwk.Kid, "kty", jwk.Kty, "error", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return errors.New("JWKS에 사용 가능한 서명 키가 없습니다")
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+	return nil
+}
+
+// parseKey JWK를 공개 키로 변환 (RS256, ES256, EdDSA만 지원)
+func parseKey(jwk jsonWebKey) (verificationKey, error) {
+	var (
+		key verificationKey
+		err error
+	)
+	switch jwk.Kty {
+	case "RSA":
+		key.alg = "RS256"
+		key.key, err = parseRSAKey(jwk)
+	case "EC":
+		key.alg = "ES256"
+		key.key, err = parseECKey(jwk)
+	case "OKP":
+		key.alg = "EdDSA"
+		key.key, err = parseEd25519Key(jwk)
+	default:
+		return key, fmt.Errorf("지원하지 않는 키 유형: %s", jwk.Kty)
+	}
+	if err != nil {
+		return key, err
+	}
+
+	if jwk.Alg != "" && jwk.Alg != key.alg {
+		return key, fmt.Errorf("지원하지 않는 알고리즘: %s", jwk.Alg)
+	}
+	return key, nil
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := decodeSegment(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("RSA 모듈러스 디코딩 실패: %w", err)
+	}
+	e, err := decodeSegment(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("RSA 지수 디코딩 실패: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) < 256 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("잘못된 RSA 키")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func parseECKey(jwk jsonWebKey) (*ecdsa.PublicKey, error) {
+	if jwk.Crv != "P-256" {
+		return nil, fmt.Errorf("지원하지 않는 곡선: %s", jwk.Crv)
+	}
+	x, err := decodeSegment(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("EC x 좌표 디코딩 실패: %w", err)
+	}
+	y, err := decodeSegment(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("EC y 좌표 디코딩 실패: %w", err)
+	}
+	if len(x) != 32 || len(y) != 32 {
+		return nil, errors.New("잘못된 EC 좌표 길이")
+	}
+
+	// 곡선 위의 점인지 확인
+	if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+		return nil, fmt.Errorf("잘못된 EC 키: %w", err)
+	}
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+func parseEd25519Key(jwk jsonWebKey) (ed25519.PublicKey, error) {
+	if jwk.Crv != "Ed25519" {
+		return nil, fmt.Errorf("지원하지 않는 곡선: %s", jwk.Crv)
+	}
+	x, err := decodeSegment(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("Ed25519 키 디코딩 실패: %w", err)
+	}
+	if len(x) != ed25519.PublicKeySize {
+		return nil, errors.New("잘못된 Ed25519 키 길이")
+	}
+	return ed25519.PublicKey(x), nil
+}
+
+func decodeSegment(value string) ([]byte, error) {
+	if value == "" {
+		return nil, errors.New("값이 비어 있습니다")
+	}
+	return base64.RawURLEncoding.DecodeString(value)
+}
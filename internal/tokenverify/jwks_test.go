@@ -0,0 +1,246 @@
+package tokenverify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/signalable/quser/internal/client"
+	"github.com/signalable/quser/internal/config"
+)
+
+type testKeys struct {
+	rsa     *rsa.PrivateKey
+	ecdsa   *ecdsa.PrivateKey
+	ed25519 ed25519.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKeys{rsa: rsaKey, ecdsa: ecKey, ed25519: edKey}
+}
+
+func (k testKeys) jwks() []jsonWebKey {
+	return []jsonWebKey{
+		rsaJWK("rsa", &k.rsa.PublicKey),
+		ecJWK("ec", &k.ecdsa.PublicKey),
+		{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: encode(k.ed25519.Public().(ed25519.PublicKey))},
+	}
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) jsonWebKey {
+	return jsonWebKey{Kty: "RSA", Kid: kid, N: encode(key.N.Bytes()), E: encode(big.NewInt(int64(key.E)).Bytes())}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) jsonWebKey {
+	return jsonWebKey{Kty: "EC", Kid: kid, Crv: "P-256", X: encode(key.X.FillBytes(make([]byte, 32))), Y: encode(key.Y.FillBytes(make([]byte, 32)))}
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestParseKey(t *testing.T) {
+	keys := newTestKeys(t)
+	jwks := keys.jwks()
+
+	withAlg := func(jwk jsonWebKey, alg string) jsonWebKey {
+		jwk.Alg = alg
+		return jwk
+	}
+	offCurve := jwks[1]
+	offCurve.Y = encode(make([]byte, 32))
+	shortRSA := jwks[0]
+	shortRSA.N = encode(make([]byte, 128))
+	wrongCurve := jwks[1]
+	wrongCurve.Crv = "P-384"
+
+	tests := []struct {
+		name    string
+		jwk     jsonWebKey
+		wantAlg string
+		wantErr bool
+	}{
+		{"rsa", jwks[0], "RS256", false},
+		{"rsa with matching alg", withAlg(jwks[0], "RS256"), "RS256", false},
+		{"ec", jwks[1], "ES256", false},
+		{"ed25519", jwks[2], "EdDSA", false},
+		{"rsa with unsupported alg", withAlg(jwks[0], "RS512"), "", true},
+		{"ec with unsupported alg", withAlg(jwks[1], "ES384"), "", true},
+		{"unsupported curve", wrongCurve, "", true},
+		{"point not on curve", offCurve, "", true},
+		{"rsa modulus too short", shortRSA, "", true},
+		{"symmetric key", jsonWebKey{Kty: "oct", Kid: "hmac"}, "", true},
+		{"missing coordinates", jsonWebKey{Kty: "OKP", Crv: "Ed25519"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := parseKey(tt.jwk)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && key.alg != tt.wantAlg {
+				t.Errorf("parseKey() alg = %s, want %s", key.alg, tt.wantAlg)
+			}
+		})
+	}
+}
+
+func TestLocalVerifierValidateToken(t *testing.T) {
+	keys := newTestKeys(t)
+	otherRSA, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks := append(keys.jwks(), jsonWebKey{Kty: "oct", Kid: "hmac"})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": jwks})
+	}))
+	defer server.Close()
+
+	v := NewLocalVerifier(server.URL, time.Second, config.TokenVerifyConfig{
+		JWKSPath: "/jwks",
+		Issuer:   "auth-service",
+		Audience: "quser",
+		Leeway:   5 * time.Second,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ctx := context.Background()
+	if err := v.keys.refresh(ctx); err != nil {
+		t.Fatalf("refresh() error = %v", err)
+	}
+	now := time.Now()
+	v.denylist.merge(nil, now.Unix(), now)
+
+	claims := func(modify func(jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"sub":   "user-1",
+			"sid":   "session-1",
+			"roles": []string{"user"},
+			"iss":   "auth-service",
+			"aud":   "quser",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any, c jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, c)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	tests := []struct {
+		name            string
+		token           string
+		wantErr         bool
+		wantUnavailable bool
+	}{
+		{"rs256", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(nil)), false, false},
+		{"es256", sign(jwt.SigningMethodES256, "ec", keys.ecdsa, claims(nil)), false, false},
+		{"eddsa", sign(jwt.SigningMethodEdDSA, "ed", keys.ed25519, claims(nil)), false, false},
+		{"unknown kid", sign(jwt.SigningMethodRS256, "rotated", keys.rsa, claims(nil)), true, false},
+		{"missing kid with several keys", sign(jwt.SigningMethodRS256, "", keys.rsa, claims(nil)), true, false},
+		{"wrong signing key", sign(jwt.SigningMethodRS256, "rsa", otherRSA, claims(nil)), true, false},
+		{"alg does not match key", sign(jwt.SigningMethodES256, "rsa", keys.ecdsa, claims(nil)), true, false},
+		{"hmac", sign(jwt.SigningMethodHS256, "hmac", []byte("secret"), claims(nil)), true, false},
+		{"unsupported alg", sign(jwt.SigningMethodRS512, "rsa", keys.rsa, claims(nil)), true, false},
+		{"wrong issuer", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(func(c jwt.MapClaims) { c["iss"] = "other" })), true, false},
+		{"wrong audience", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(func(c jwt.MapClaims) { c["aud"] = "other" })), true, false},
+		{"expired", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Minute).Unix() })), true, false},
+		{"expired within leeway", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(func(c jwt.MapClaims) { c["exp"] = now.Add(-2 * time.Second).Unix() })), false, false},
+		{"missing exp", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(func(c jwt.MapClaims) { delete(c, "exp") })), true, false},
+		{"missing sub", sign(jwt.SigningMethodRS256, "rsa", keys.rsa, claims(func(c jwt.MapClaims) { delete(c, "sub") })), true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := v.ValidateToken(ctx, tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if client.IsUnavailable(err) != tt.wantUnavailable {
+				t.Errorf("ValidateToken() error = %v, unavailable = %v, want %v", err, client.IsUnavailable(err), tt.wantUnavailable)
+			}
+			if err == nil && (resp.UserID != "user-1" || resp.SessionID != "session-1" || len(resp.Roles) != 1) {
+				t.Errorf("ValidateToken() = %+v", resp)
+			}
+		})
+	}
+}
+
+func TestKeySetRefetchesRotatedKey(t *testing.T) {
+	keys := newTestKeys(t)
+	jwks := []jsonWebKey{rsaJWK("old", &keys.rsa.PublicKey)}
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		json.NewEncoder(w).Encode(map[string]any{"keys": jwks})
+	}))
+	defer server.Close()
+
+	ks := newKeySet(server.URL, server.Client(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+	if err := ks.refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 위조 토큰의 임의 kid로 인한 재조회가 교체된 키의 재조회를 막지 않아야 함
+	ks.lastFetch = time.Time{}
+	if _, err := ks.key(ctx, "forged"); err == nil {
+		t.Fatal("key(forged) succeeded")
+	}
+	jwks = append(jwks, rsaJWK("new", &keys.rsa.PublicKey))
+	ks.lastFetch = time.Time{}
+	if _, err := ks.key(ctx, "new"); err != nil {
+		t.Fatalf("key(new) error = %v", err)
+	}
+
+	// 같은 kid는 제한 시간 동안 다시 받지 않음
+	before := fetches
+	ks.lastFetch = time.Time{}
+	if _, err := ks.key(ctx, "forged"); err == nil {
+		t.Fatal("key(forged) succeeded")
+	}
+	if fetches != before {
+		t.Errorf("forged kid refetched within %s", minRefetchInterval)
+	}
+}
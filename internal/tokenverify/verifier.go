@@ -0,0 +1,207 @@
+// quser/internal/tokenverify/verifier.go
+package tokenverify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/signalable/quser/internal/client"
+	"github.com/signalable/quser/internal/config"
+)
+
+// ErrDenylistNotSynced 폐기 목록을 아직 한 번도 받지 못해 폐기 여부를 확인할 수 없음
+var ErrDenylistNotSynced = fmt.Errorf("%w: 토큰 폐기 목록이 동기화되지 않았습니다", client.ErrUnavailable)
+
+// ErrDenylistStale 마지막 동기화 후 허용 시간이 지나 최근 폐기를 놓쳤을 수 있음
+var ErrDenylistStale = fmt.Errorf("%w: 토큰 폐기 목록이 오래되었습니다", client.ErrUnavailable)
+
+// supportedAlgorithms 허용 서명 알고리즘 (none 및 HMAC 계열 차단)
+var supportedAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// accessClaims Auth 서비스가 발급하는 액세스 토큰 클레임
+type accessClaims struct {
+	jwt.RegisteredClaims
+	SessionID string   `json:"sid,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+}
+
+// LocalVerifier Auth 서비스 호출 없이 JWT 서명과 클레임을 직접 검증하는 토큰 검증기
+//
+// 서명 키는 Auth 서비스의 JWKS에서, 폐기 여부는 주기적으로 동기화하는 폐기 목록에서 확인한다.
+// 두 작업 모두 백그라운드에서 실행되므로 Auth 서비스가 잠시 중단되어도 검증은 계속된다.
+type LocalVerifier struct {
+	keys     *keySet
+	denylist *denylist
+	parser   *jwt.Parser
+	cfg      config.TokenVerifyConfig
+	logger   *slog.Logger
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewLocalVerifier 로컬 토큰 검증기 생성자 (authURL은 JWKS·폐기 목록 경로의 기준 URL)
+func NewLocalVerifier(authURL string, timeout time.Duration, cfg config.TokenVerifyConfig, logger *slog.Logger) *LocalVerifier {
+	httpClient := &http.Client{
+		Timeout: timeout,
+		Transport: otelhttp.NewTransport(
+			http.DefaultTransport,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return "AuthService " + r.Method + " " + r.URL.Path
+			}),
+		),
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(supportedAlgorithms),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+
+	logger = logger.With("component", "token_verifier")
+	return &LocalVerifier{
+		keys:     newKeySet(authURL+cfg.JWKSPath, httpClient, logger),
+		denylist: newDenylist(authURL+cfg.DenylistPath, httpClient, cfg.Leeway, cfg.DenylistMaxStaleness),
+		parser:   jwt.NewParser(options...),
+		cfg:      cfg,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// ValidateToken 토큰 서명, 만료, 발급자·대상, 폐기 여부 검증
+func (v *LocalVerifier) ValidateToken(ctx context.Context, token string) (*client.TokenValidationResponse, error) {
+	var claims accessClaims
+	_, err := v.parser.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := v.keys.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.alg != t.Method.Alg() {
+			return nil, fmt.Errorf("키와 토큰의 알고리즘 불일치: %s", t.Method.Alg())
+		}
+		return key.key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("토큰 검증 실패: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("유효하지 않은 토큰")
+	}
+
+	revoked, err := v.denylist.isRevoked(&claims, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, fmt.Errorf("폐기된 토큰")
+	}
+
+	return &client.TokenValidationResponse{
+		Valid:     true,
+		UserID:    claims.Subject,
+		Roles:     claims.Roles,
+		ExpiresAt: claims.ExpiresAt.Unix(),
+		SessionID: claims.SessionID,
+	}, nil
+}
+
+// Ready 검증 가능 여부 확인 (서명 키가 있고 폐기 목록이 최근에 동기화되었는지)
+//
+// Auth 서비스가 잠시 중단되어도 사본이 유효한 동안은 정상으로 보고한다.
+func (v *LocalVerifier) Ready(_ context.Context) error {
+	if !v.keys.loaded() {
+		return fmt.Errorf("%w: JWKS를 아직 받지 못했습니다", client.ErrUnavailable)
+	}
+	return v.denylist.fresh(time.Now())
+}
+
+// Start JWKS 갱신 및 폐기 목록 동기화 시작 (첫 조회는 즉시 실행)
+func (v *LocalVerifier) Start() {
+	go v.run()
+}
+
+// Stop 백그라운드 작업 중지 (진행 중인 조회가 끝나거나 ctx가 만료될 때까지 대기)
+func (v *LocalVerifier) Stop(ctx context.Context) error {
+	close(v.stop)
+
+	select {
+	case <-v.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (v *LocalVerifier) run() {
+	defer close(v.done)
+
+	v.refreshKeys()
+	v.syncDenylist()
+
+	keysTicker := time.NewTicker(v.cfg.JWKSRefreshInterval)
+	defer keysTicker.Stop()
+	denylistTicker := time.NewTicker(v.cfg.DenylistSyncInterval)
+	defer denylistTicker.Stop()
+
+	for {
+		select {
+		case <-v.stop:
+			return
+		case <-keysTicker.C:
+			v.refreshKeys()
+		case <-denylistTicker.C:
+			v.syncDenylist()
+		}
+	}
+}
+
+func (v *LocalVerifier) refreshKeys() {
+	ctx, cancel := v.taskContext()
+	defer cancel()
+
+	if err := v.keys.refresh(ctx); err != nil {
+		v.logger.ErrorContext(ctx, "JWKS 갱신 실패", "error", err)
+	}
+}
+
+func (v *LocalVerifier) syncDenylist() {
+	ctx, cancel := v.taskContext()
+	defer cancel()
+
+	count, err := v.denylist.sync(ctx)
+	if err != nil {
+		v.logger.ErrorContext(ctx, "토큰 폐기 목록 동기화 실패", "error", err)
+		return
+	}
+	if count > 0 {
+		v.logger.DebugContext(ctx, "토큰 폐기 목록 동기화 완료", "count", count)
+	}
+}
+
+// taskContext 중지 요청 시 취소되는 백그라운드 작업 컨텍스트
+func (v *LocalVerifier) taskContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-v.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
@@ -0,0 +1,74 @@
+// quser/internal/tracing/tracing.go
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/signalable/quser/internal/config"
+)
+
+// instrumentationName 서비스 내부 스팬의 계측 라이브러리 이름
+const instrumentationName = "github.com/signalable/quser"
+
+// Setup 전역 TracerProvider와 W3C 전파기 설정
+//
+// 반환된 함수는 종료 시 남은 스팬을 내보낸다.
+func Setup(ctx context.Context, cfg config.TracingConfig) (func(context.Context) error, error) {
+	// 트레이싱을 끄더라도 들어온 traceparent는 그대로 전달
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("트레이스 익스포터 생성 실패: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("트레이스 리소스 생성 실패: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// newExporter 설정된 익스포터 생성
+//
+// stdout 익스포터는 로그와 같은 스트림에 쓰므로 로컬 디버깅용으로 명시한 경우에만 사용하고,
+// 로그 수집기가 줄 단위로 읽을 수 있도록 스팬마다 한 줄로 출력한다.
+func newExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "stdout":
+		return stdouttrace.New()
+	case "otlp":
+		if cfg.OTLPEndpoint == "" {
+			return nil, fmt.Errorf("OTLP 익스포터에는 OTEL_EXPORTER_OTLP_ENDPOINT 설정이 필요합니다")
+		}
+		return otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+	}
+	return nil, fmt.Errorf("지원하지 않는 트레이스 익스포터: %s", cfg.Exporter)
+}
@@ -0,0 +1,176 @@
+// quser/internal/tracing/user_usecase.go
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/signalable/quser/internal/domain"
+	"github.com/signalable/quser/internal/usecase"
+)
+
+// tracedUserUseCase 메서드마다 스팬을 생성하는 UserUseCase 데코레이터
+//
+// 새 메서드가 추적에서 누락되지 않도록 인터페이스를 임베드하지 않고 모두 구현한다.
+type tracedUserUseCase struct {
+	next   usecase.UserUseCase
+	tracer trace.Tracer
+}
+
+// NewTracedUserUseCase 트레이싱 UserUseCase 생성자
+func NewTracedUserUseCase(next usecase.UserUseCase) usecase.UserUseCase {
+	return &tracedUserUseCase{
+		next:   next,
+		tracer: otel.Tracer(instrumentationName),
+	}
+}
+
+func (uc *tracedUserUseCase) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return uc.tracer.Start(ctx, "UserUseCase."+method, trace.WithAttributes(attrs...))
+}
+
+// end 에러를 스팬에 기록하고 종료
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func userIDAttr(userID string) attribute.KeyValue {
+	return attribute.String("user.id", userID)
+}
+
+func (uc *tracedUserUseCase) Register(ctx context.Context, req *domain.RegisterRequest) (err error) {
+	ctx, span := uc.start(ctx, "Register")
+	defer func() { end(span, err) }()
+	return uc.next.Register(ctx, req)
+}
+
+func (uc *tracedUserUseCase) Login(ctx context.Context, email, password string, clientInfo domain.ClientInfo) (resp *domain.LoginResponse, err error) {
+	ctx, span := uc.start(ctx, "Login")
+	defer func() { end(span, err) }()
+	return uc.next.Login(ctx, email, password, clientInfo)
+}
+
+func (uc *tracedUserUseCase) ListLoginEvents(ctx context.Context, userID string, limit int) (resp *domain.LoginHistoryResponse, err error) {
+	ctx, span := uc.start(ctx, "ListLoginEvents", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.ListLoginEvents(ctx, userID, limit)
+}
+
+func (uc *tracedUserUseCase) GetProfile(ctx context.Context, userID string) (resp *domain.UserResponse, err error) {
+	ctx, span := uc.start(ctx, "GetProfile", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.GetProfile(ctx, userID)
+}
+
+func (uc *tracedUserUseCase) UpdateProfile(ctx context.Context, userID string, req *domain.UpdateProfileRequest) (err error) {
+	ctx, span := uc.start(ctx, "UpdateProfile", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.UpdateProfile(ctx, userID, req)
+}
+
+func (uc *tracedUserUseCase) VerifyEmail(ctx context.Context, userID string, token string) (err error) {
+	ctx, span := uc.start(ctx, "VerifyEmail", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.VerifyEmail(ctx, userID, token)
+}
+
+func (uc *tracedUserUseCase) ResendVerification(ctx context.Context, email string) (err error) {
+	ctx, span := uc.start(ctx, "ResendVerification")
+	defer func() { end(span, err) }()
+	return uc.next.ResendVerification(ctx, email)
+}
+
+func (uc *tracedUserUseCase) ForgotPassword(ctx context.Context, email string) (err error) {
+	ctx, span := uc.start(ctx, "ForgotPassword")
+	defer func() { end(span, err) }()
+	return uc.next.ForgotPassword(ctx, email)
+}
+
+func (uc *tracedUserUseCase) ResetPassword(ctx context.Context, token, newPassword string) (err error) {
+	ctx, span := uc.start(ctx, "ResetPassword")
+	defer func() { end(span, err) }()
+	return uc.next.ResetPassword(ctx, token, newPassword)
+}
+
+func (uc *tracedUserUseCase) GetUserStatus(ctx context.Context, userID string) (status string, err error) {
+	ctx, span := uc.start(ctx, "GetUserStatus", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.GetUserStatus(ctx, userID)
+}
+
+func (uc *tracedUserUseCase) FindByEmail(ctx context.Context, email string) (resp *domain.UserResponse, err error) {
+	ctx, span := uc.start(ctx, "FindByEmail")
+	defer func() { end(span, err) }()
+	return uc.next.FindByEmail(ctx, email)
+}
+
+func (uc *tracedUserUseCase) ListUsers(ctx context.Context, filter domain.UserFilter, page domain.Page) (resp *domain.UserListResponse, err error) {
+	ctx, span := uc.start(ctx, "ListUsers", attribute.Int("page.limit", page.Limit))
+	defer func() { end(span, err) }()
+	return uc.next.ListUsers(ctx, filter, page)
+}
+
+func (uc *tracedUserUseCase) Logout(ctx context.Context, token string) (err error) {
+	ctx, span := uc.start(ctx, "Logout")
+	defer func() { end(span, err) }()
+	return uc.next.Logout(ctx, token)
+}
+
+func (uc *tracedUserUseCase) ListSessions(ctx context.Context, userID, currentSessionID string) (resp *domain.SessionListResponse, err error) {
+	ctx, span := uc.start(ctx, "ListSessions", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.ListSessions(ctx, userID, currentSessionID)
+}
+
+func (uc *tracedUserUseCase) RevokeSession(ctx context.Context, userID, sessionID string) (err error) {
+	ctx, span := uc.start(ctx, "RevokeSession", userIDAttr(userID), attribute.String("session.id", sessionID))
+	defer func() { end(span, err) }()
+	return uc.next.RevokeSession(ctx, userID, sessionID)
+}
+
+func (uc *tracedUserUseCase) RevokeAllSessions(ctx context.Context, userID string) (err error) {
+	ctx, span := uc.start(ctx, "RevokeAllSessions", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.RevokeAllSessions(ctx, userID)
+}
+
+func (uc *tracedUserUseCase) TouchSession(ctx context.Context, sessionID string) (err error) {
+	ctx, span := uc.start(ctx, "TouchSession", attribute.String("session.id", sessionID))
+	defer func() { end(span, err) }()
+	return uc.next.TouchSession(ctx, sessionID)
+}
+
+func (uc *tracedUserUseCase) DeleteAccount(ctx context.Context, userID string) (err error) {
+	ctx, span := uc.start(ctx, "DeleteAccount", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.DeleteAccount(ctx, userID)
+}
+
+func (uc *tracedUserUseCase) RestoreAccount(ctx context.Context, userID string) (err error) {
+	ctx, span := uc.start(ctx, "RestoreAccount", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.RestoreAccount(ctx, userID)
+}
+
+func (uc *tracedUserUseCase) UnlockAccount(ctx context.Context, userID string) (err error) {
+	ctx, span := uc.start(ctx, "UnlockAccount", userIDAttr(userID))
+	defer func() { end(span, err) }()
+	return uc.next.UnlockAccount(ctx, userID)
+}
+
+func (uc *tracedUserUseCase) PurgeDeletedAccounts(ctx context.Context) (purged int, err error) {
+	ctx, span := uc.start(ctx, "PurgeDeletedAccounts")
+	defer func() {
+		span.SetAttributes(attribute.Int("purged.count", purged))
+		end(span, err)
+	}()
+	return uc.next.PurgeDeletedAccounts(ctx)
+}
@@ -11,17 +11,43 @@ type UserUseCase interface {
 	// 회원가입
 	Register(ctx context.Context, req *domain.RegisterRequest) error
 	// 로그인
-	Login(ctx context.Context, email, password string) (*domain.LoginResponse, error)
+	Login(ctx context.Context, email, password string, clientInfo domain.ClientInfo) (*domain.LoginResponse, error)
+	// 최근 로그인 기록 조회
+	ListLoginEvents(ctx context.Context, userID string, limit int) (*domain.LoginHistoryResponse, error)
 	// 프로필 조회
 	GetProfile(ctx context.Context, userID string) (*domain.UserResponse, error)
 	// 프로필 업데이트
 	UpdateProfile(ctx context.Context, userID string, req *domain.UpdateProfileRequest) error
 	// 이메일 인증
 	VerifyEmail(ctx context.Context, userID string, token string) error
+	// 인증 메일 재발송
+	ResendVerification(ctx context.Context, email string) error
+	// 비밀번호 재설정 메일 요청
+	ForgotPassword(ctx context.Context, email string) error
+	// 비밀번호 재설정
+	ResetPassword(ctx context.Context, token, newPassword string) error
 	// 사용자 상태 조회
 	GetUserStatus(ctx context.Context, userID string) (string, error)
 	// 이메일로 사용자 찾기
 	FindByEmail(ctx context.Context, email string) (*domain.UserResponse, error)
+	// 사용자 목록 조회 (관리자)
+	ListUsers(ctx context.Context, filter domain.UserFilter, page domain.Page) (*domain.UserListResponse, error)
 	// 로그아웃
 	Logout(ctx context.Context, token string) error
+	// 활성 세션 목록 조회
+	ListSessions(ctx context.Context, userID, currentSessionID string) (*domain.SessionListResponse, error)
+	// 세션 원격 로그아웃
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	// 모든 기기에서 로그아웃
+	RevokeAllSessions(ctx context.Context, userID string) error
+	// 세션 마지막 활동 시각 갱신
+	TouchSession(ctx context.Context, sessionID string) error
+	// 계정 삭제 (소프트 삭제)
+	DeleteAccount(ctx context.Context, userID string) error
+	// 삭제된 계정 복구 (관리자, 유예 기간 내)
+	RestoreAccount(ctx context.Context, userID string) error
+	// 로그인 실패 잠금 해제 (관리자)
+	UnlockAccount(ctx context.Context, userID string) error
+	// 유예 기간이 지난 계정 영구 삭제
+	PurgeDeletedAccounts(ctx context.Context) (int, error)
 }
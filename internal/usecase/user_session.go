@@ -0,0 +1,53 @@
+// quser/internal/usecase/user_session.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/signalable/quser/internal/domain"
+)
+
+// ListSessions 활성 세션 목록 조회 구현 (currentSessionID와 같은 세션은 현재 세션으로 표시)
+func (uc *userUseCase) ListSessions(ctx context.Context, userID, currentSessionID string) (*domain.SessionListResponse, error) {
+	sessions, err := uc.sessionRepo.ListActiveByUserID(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		session.Current = session.ID.Hex() == currentSessionID
+	}
+	return &domain.SessionListResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession 세션 원격 로그아웃 구현
+//
+// 다른 사용자의 세션 ID로 Auth 서비스를 호출하지 않도록 소유 여부를 먼저 확인하고,
+// 토큰 폐기가 성공한 경우에만 세션을 폐기 처리한다.
+func (uc *userUseCase) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	now := time.Now()
+	if _, err := uc.sessionRepo.FindActive(ctx, userID, sessionID, now); err != nil {
+		return err
+	}
+
+	if err := uc.authClient.RevokeSession(ctx, userID, sessionID); err != nil {
+		return authServiceError(err)
+	}
+
+	return uc.sessionRepo.Revoke(ctx, userID, sessionID, now)
+}
+
+// RevokeAllSessions 모든 기기에서 로그아웃 구현
+func (uc *userUseCase) RevokeAllSessions(ctx context.Context, userID string) error {
+	if err := uc.authClient.RevokeAllUserTokens(ctx, userID); err != nil {
+		return authServiceError(err)
+	}
+
+	return uc.sessionRepo.RevokeAllByUserID(ctx, userID, time.Now())
+}
+
+// TouchSession 세션 마지막 활동 시각 갱신 구현 (설정된 간격 이내의 반복 갱신은 생략)
+func (uc *userUseCase) TouchSession(ctx context.Context, sessionID string) error {
+	return uc.sessionRepo.Touch(ctx, sessionID, time.Now(), uc.sessionCfg.TouchInterval)
+}
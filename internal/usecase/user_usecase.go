@@ -3,87 +3,294 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
 	"time"
 
 	"github.com/signalable/quser/internal/client"
+	"github.com/signalable/quser/internal/config"
 	"github.com/signalable/quser/internal/domain"
+	"github.com/signalable/quser/internal/emailnorm"
+	"github.com/signalable/quser/internal/mailer"
 	"github.com/signalable/quser/internal/repository"
+	"github.com/signalable/quser/internal/security"
+	"github.com/signalable/quser/internal/useragent"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type userUseCase struct {
-	userRepo   repository.UserRepository
-	authClient *client.AuthClient
+	userRepo               repository.UserRepository
+	verificationTokenRepo  repository.UserTokenRepository
+	passwordResetTokenRepo repository.UserTokenRepository
+	loginEventRepo         repository.LoginEventRepository
+	sessionRepo            repository.SessionRepository
+	authClient             client.AuthService
+	passwordHasher         security.PasswordHasher
+	emailNormalizer        *emailnorm.Normalizer
+	mailer                 mailer.Mailer
+	verificationCfg        config.VerificationConfig
+	passwordResetCfg       config.PasswordResetConfig
+	deletionCfg            config.DeletionConfig
+	lockoutCfg             config.LockoutConfig
+	loginHistoryCfg        config.LoginHistoryConfig
+	sessionCfg             config.SessionConfig
+	logger                 *slog.Logger
+	// 존재하지 않는 사용자 로그인 시 응답 시간을 맞추기 위한 더미 해시
+	dummyHash string
 }
 
 // NewUserUseCase User 유스케이스 생성자
 func NewUserUseCase(
 	userRepo repository.UserRepository,
-	authClient *client.AuthClient,
-) UserUseCase {
-	return &userUseCase{
-		userRepo:   userRepo,
-		authClient: authClient,
+	verificationTokenRepo repository.UserTokenRepository,
+	passwordResetTokenRepo repository.UserTokenRepository,
+	loginEventRepo repository.LoginEventRepository,
+	sessionRepo repository.SessionRepository,
+	authClient client.AuthService,
+	passwordHasher security.PasswordHasher,
+	emailNormalizer *emailnorm.Normalizer,
+	mailer mailer.Mailer,
+	verificationCfg config.VerificationConfig,
+	passwordResetCfg config.PasswordResetConfig,
+	deletionCfg config.DeletionConfig,
+	lockoutCfg config.LockoutConfig,
+	loginHistoryCfg config.LoginHistoryConfig,
+	sessionCfg config.SessionConfig,
+	logger *slog.Logger,
+) (UserUseCase, error) {
+	// 더미 해시 없이는 존재하지 않는 사용자의 로그인이 즉시 끝나 응답 시간으로 가입 여부가 드러나므로 기동 실패
+	dummyHash, err := passwordHasher.Hash("quser-dummy-password")
+	if err != nil {
+		return nil, fmt.Errorf("더미 비밀번호 해시 생성 실패: %w", err)
 	}
+
+	return &userUseCase{
+		userRepo:               userRepo,
+		verificationTokenRepo:  verificationTokenRepo,
+		passwordResetTokenRepo: passwordResetTokenRepo,
+		loginEventRepo:         loginEventRepo,
+		sessionRepo:            sessionRepo,
+		authClient:             authClient,
+		passwordHasher:         passwordHasher,
+		emailNormalizer:        emailNormalizer,
+		mailer:                 mailer,
+		verificationCfg:        verificationCfg,
+		passwordResetCfg:       passwordResetCfg,
+		deletionCfg:            deletionCfg,
+		lockoutCfg:             lockoutCfg,
+		loginHistoryCfg:        loginHistoryCfg,
+		sessionCfg:             sessionCfg,
+		logger:                 logger,
+		dummyHash:              dummyHash,
+	}, nil
 }
 
 // Register 회원가입 구현
 func (uc *userUseCase) Register(ctx context.Context, req *domain.RegisterRequest) error {
-	// 이메일 중복 체크
-	exists, err := uc.userRepo.ExistsByEmail(ctx, req.Email)
+	// 이메일 정규화
+	email, normalizedEmail, err := uc.emailNormalizer.Normalize(req.Email)
 	if err != nil {
-		return err
+		return domain.ErrInvalidEmail
 	}
-	if exists {
-		return domain.ErrEmailAlreadyExists
+
+	// 비밀번호 해시 생성
+	passwordHash, err := uc.passwordHasher.Hash(req.Password)
+	if err != nil {
+		return err
 	}
 
 	// 새 사용자 생성
 	user := &domain.User{
-		Email:  req.Email,
-		Name:   req.Name,
-		Status: domain.UserStatusPending,
-		Profile: &domain.UserProfile{
-			LastLogin: time.Now(),
-		},
+		Email:     email,
+		EmailNorm: normalizedEmail,
+		Password:  passwordHash,
+		Name:      req.Name,
+		Status:    domain.UserStatusPending,
+		Profile:   &domain.UserProfile{},
 	}
 
-	// 사용자 저장
+	// 사용자 저장 (이메일 중복 시 ErrEmailAlreadyExists)
 	if err := uc.userRepo.Create(ctx, user); err != nil {
 		return err
 	}
 
+	// 인증 메일 발송 (실패해도 재발송 가능하므로 가입은 완료 처리)
+	if err := uc.sendVerificationEmail(ctx, user); err != nil {
+		uc.logger.WarnContext(ctx, "인증 메일 발송 실패", "user_id", user.ID.Hex(), "error", err)
+	}
+
 	return nil
 }
 
-// Login 로그인 구현
-func (uc *userUseCase) Login(ctx context.Context, email, password string) (*domain.LoginResponse, error) {
+// Login 로그인 구현 (성공·실패와 관계없이 시도를 기록)
+func (uc *userUseCase) Login(ctx context.Context, email, password string, clientInfo domain.ClientInfo) (*domain.LoginResponse, error) {
+	now := time.Now()
+	event := &domain.LoginEvent{
+		IP:        clientInfo.IP,
+		UserAgent: clientInfo.UserAgent,
+		CreatedAt: now,
+		ExpiresAt: now.Add(uc.loginHistoryCfg.Retention),
+	}
+
+	resp, err := uc.login(ctx, email, password, now, event)
+	if err != nil && event.FailureReason == "" {
+		event.FailureReason = domain.LoginFailureInternal
+	}
+	event.Success = err == nil
+
+	if recordErr := uc.loginEventRepo.Create(ctx, event); recordErr != nil {
+		uc.logger.WarnContext(ctx, "로그인 기록 저장 실패", "error", recordErr)
+	}
+	return resp, err
+}
+
+// login 로그인 처리 (실패 시 event에 사유 기록)
+func (uc *userUseCase) login(ctx context.Context, email, password string, now time.Time, event *domain.LoginEvent) (*domain.LoginResponse, error) {
 	// 사용자 조회
-	user, err := uc.userRepo.FindByEmail(ctx, email)
+	user, err := uc.findByEmail(ctx, email)
 	if err != nil {
-		return nil, domain.ErrInvalidCredentials
+		if errors.Is(err, domain.ErrUserNotFound) {
+			// 사용자 존재 여부가 응답 시간으로 드러나지 않도록 더미 검증 수행
+			uc.passwordHasher.Verify(password, uc.dummyHash)
+			event.FailureReason = domain.LoginFailureUserNotFound
+			return nil, domain.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	event.UserID = user.ID
+
+	// 잠긴 계정은 비밀번호를 확인하지 않음 (잠금 중 추측 시도 차단)
+	if user.IsLocked(now) {
+		event.FailureReason = domain.LoginFailureAccountLocked
+		return nil, &domain.RetryAfterError{Err: domain.ErrAccountLocked, RetryAfter: user.LockedUntil.Sub(now)}
+	}
+
+	// 비밀번호 검증 (해시가 없는 계정도 응답 시간을 맞추고 일반 실패로 기록)
+	var ok bool
+	if user.Password == "" {
+		uc.passwordHasher.Verify(password, uc.dummyHash)
+	} else {
+		ok, err = uc.passwordHasher.Verify(password, user.Password)
+	}
+	if err != nil || !ok {
+		event.FailureReason = domain.LoginFailureInvalidPassword
+		return nil, uc.recordFailedLogin(ctx, user.ID.Hex(), now)
+	}
+
+	if user.FailedLoginCount > 0 || user.LockedUntil != nil {
+		if err := uc.userRepo.ResetFailedLogins(ctx, user.ID.Hex()); err != nil {
+			uc.logger.WarnContext(ctx, "로그인 실패 기록 초기화 실패", "user_id", user.ID.Hex(), "error", err)
+		}
+	}
+
+	// 해시 파라미터가 변경된 경우 재해시 (실패해도 로그인은 진행)
+	if uc.passwordHasher.NeedsRehash(user.Password) {
+		if newHash, err := uc.passwordHasher.Hash(password); err == nil {
+			if err := uc.userRepo.UpdatePassword(ctx, user.ID.Hex(), newHash); err != nil {
+				uc.logger.WarnContext(ctx, "비밀번호 재해시 저장 실패", "user_id", user.ID.Hex(), "error", err)
+			}
+		}
 	}
 
-	// Auth Service에 토큰 생성 요청
-	authResp, err := uc.authClient.CreateToken(ctx, user.ID.Hex())
+	// Auth Service에 세션 토큰 생성 요청
+	sessionID := primitive.NewObjectID()
+	authResp, err := uc.authClient.CreateToken(ctx, user.ID.Hex(), sessionID.Hex())
 	if err != nil {
-		return nil, err
+		event.FailureReason = domain.LoginFailureTokenIssue
+		return nil, authServiceError(err)
+	}
+
+	// 세션 기록 (실패해도 로그인은 진행)
+	ttl := time.Duration(authResp.ExpiresIn) * time.Second
+	if ttl <= 0 {
+		ttl = uc.sessionCfg.DefaultTTL
+	}
+	session := &domain.Session{
+		ID:         sessionID,
+		UserID:     user.ID,
+		Device:     useragent.Describe(event.UserAgent),
+		IP:         event.IP,
+		UserAgent:  event.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+	if err := uc.sessionRepo.Create(ctx, session); err != nil {
+		uc.logger.WarnContext(ctx, "세션 기록 저장 실패", "user_id", user.ID.Hex(), "error", err)
+	}
+
+	// 마지막 로그인 시각 갱신 (실패해도 로그인은 진행)
+	if err := uc.userRepo.UpdateLastLogin(ctx, user.ID.Hex(), now); err != nil {
+		uc.logger.WarnContext(ctx, "마지막 로그인 시각 갱신 실패", "user_id", user.ID.Hex(), "error", err)
+	} else {
+		if user.Profile == nil {
+			user.Profile = &domain.UserProfile{}
+		}
+		user.Profile.LastLogin = &now
 	}
 
 	// 로그인 응답 생성
 	return &domain.LoginResponse{
 		AccessToken: authResp.AccessToken,
-		User: &domain.UserResponse{
-			ID:         user.ID.Hex(),
-			Email:      user.Email,
-			Name:       user.Name,
-			Status:     user.Status,
-			IsVerified: user.IsVerified,
-			Profile:    user.Profile,
-			CreatedAt:  user.CreatedAt,
-		},
+		User:        newUserResponse(user),
 	}, nil
 }
 
+// recordFailedLogin 로그인 실패 기록 (이번 실패로 잠긴 경우 잠금 에러 반환)
+func (uc *userUseCase) recordFailedLogin(ctx context.Context, userID string, now time.Time) error {
+	user, err := uc.userRepo.RecordFailedLogin(ctx, userID, now, domain.LockoutPolicy{
+		Threshold:    uc.lockoutCfg.Threshold,
+		BaseDuration: uc.lockoutCfg.BaseDuration,
+		MaxDuration:  uc.lockoutCfg.MaxDuration,
+		ResetAfter:   uc.lockoutCfg.ResetAfter,
+	})
+	if err != nil {
+		uc.logger.WarnContext(ctx, "로그인 실패 기록 실패", "user_id", userID, "error", err)
+		return domain.ErrInvalidCredentials
+	}
+
+	if user.IsLocked(now) {
+		return &domain.RetryAfterError{Err: domain.ErrAccountLocked, RetryAfter: user.LockedUntil.Sub(now)}
+	}
+	return domain.ErrInvalidCredentials
+}
+
+// ListLoginEvents 최근 로그인 기록 조회 구현
+func (uc *userUseCase) ListLoginEvents(ctx context.Context, userID string, limit int) (*domain.LoginHistoryResponse, error) {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = domain.DefaultPageLimit
+	}
+	if limit > domain.MaxPageLimit {
+		limit = domain.MaxPageLimit
+	}
+
+	events, err := uc.loginEventRepo.ListByUserID(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.LoginHistoryResponse{Events: events}, nil
+}
+
+// UnlockAccount 계정 잠금 해제 구현 (관리자)
+func (uc *userUseCase) UnlockAccount(ctx context.Context, userID string) error {
+	if _, err := uc.userRepo.FindByID(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.ResetFailedLogins(ctx, userID); err != nil {
+		return err
+	}
+
+	uc.logger.InfoContext(ctx, "계정 잠금 해제", "user_id", userID)
+	return nil
+}
+
 // GetProfile 프로필 조회 구현
 func (uc *userUseCase) GetProfile(ctx context.Context, userID string) (*domain.UserResponse, error) {
 	user, err := uc.userRepo.FindByID(ctx, userID)
@@ -91,15 +298,7 @@ func (uc *userUseCase) GetProfile(ctx context.Context, userID string) (*domain.U
 		return nil, err
 	}
 
-	return &domain.UserResponse{
-		ID:         user.ID.Hex(),
-		Email:      user.Email,
-		Name:       user.Name,
-		Status:     user.Status,
-		IsVerified: user.IsVerified,
-		Profile:    user.Profile,
-		CreatedAt:  user.CreatedAt,
-	}, nil
+	return newUserResponse(user), nil
 }
 
 // UpdateProfile 프로필 업데이트 구현
@@ -132,8 +331,19 @@ func (uc *userUseCase) UpdateProfile(ctx context.Context, userID string, req *do
 
 // VerifyEmail 이메일 인증 구현
 func (uc *userUseCase) VerifyEmail(ctx context.Context, userID string, token string) error {
-	// 토큰 유효성 검증
-	if err := uc.authClient.ValidateToken(ctx, token); err != nil {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsVerified {
+		return nil
+	}
+
+	// 토큰 사용 처리 (만료·재사용은 실패, 다른 사용자의 토큰은 사용 처리하지 않고 실패)
+	if _, err := uc.verificationTokenRepo.ConsumeForUser(ctx, userID, security.HashToken(token)); err != nil {
+		if errors.Is(err, domain.ErrInvalidToken) {
+			return domain.ErrEmailVerification
+		}
 		return err
 	}
 
@@ -142,9 +352,48 @@ func (uc *userUseCase) VerifyEmail(ctx context.Context, userID string, token str
 		return err
 	}
 
+	// 남은 인증 토큰 정리
+	if err := uc.verificationTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		uc.logger.WarnContext(ctx, "인증 토큰 정리 실패", "user_id", userID, "error", err)
+	}
+
 	return nil
 }
 
+// ResendVerification 인증 메일 재발송 구현 (이메일 존재 여부는 노출하지 않음)
+func (uc *userUseCase) ResendVerification(ctx context.Context, email string) error {
+	user, err := uc.findByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.IsVerified {
+		return nil
+	}
+
+	// 재발송 제한(최소 간격 및 시간당 최대 횟수)에 걸리면 조용히 무시 (계정 존재 여부 노출 방지)
+	now := time.Now()
+	recent, err := uc.verificationTokenRepo.CountCreatedSince(ctx, user.ID.Hex(), now.Add(-uc.verificationCfg.ResendCooldown))
+	if err != nil {
+		return err
+	}
+	if recent > 0 {
+		return nil
+	}
+
+	hourly, err := uc.verificationTokenRepo.CountCreatedSince(ctx, user.ID.Hex(), now.Add(-time.Hour))
+	if err != nil {
+		return err
+	}
+	if hourly >= int64(uc.verificationCfg.ResendMaxPerHour) {
+		return nil
+	}
+
+	return uc.sendVerificationEmail(ctx, user)
+}
+
 // GetUserStatus 사용자 상태 조회 구현
 func (uc *userUseCase) GetUserStatus(ctx context.Context, userID string) (string, error) {
 	user, err := uc.userRepo.FindByID(ctx, userID)
@@ -156,11 +405,269 @@ func (uc *userUseCase) GetUserStatus(ctx context.Context, userID string) (string
 
 // FindByEmail 이메일로 사용자 찾기 구현
 func (uc *userUseCase) FindByEmail(ctx context.Context, email string) (*domain.UserResponse, error) {
-	user, err := uc.userRepo.FindByEmail(ctx, email)
+	user, err := uc.findByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return newUserResponse(user), nil
+}
+
+// ListUsers 사용자 목록 조회 구현
+func (uc *userUseCase) ListUsers(ctx context.Context, filter domain.UserFilter, page domain.Page) (*domain.UserListResponse, error) {
+	if page.Limit <= 0 {
+		page.Limit = domain.DefaultPageLimit
+	}
+	if page.Limit > domain.MaxPageLimit {
+		page.Limit = domain.MaxPageLimit
+	}
+	// 이메일 접두어는 저장된 정규화 이메일과 같은 규칙으로 비교
+	if filter.EmailPrefix != "" {
+		filter.EmailPrefix = uc.emailNormalizer.CanonicalPrefix(filter.EmailPrefix)
+	}
+
+	result, err := uc.userRepo.List(ctx, filter, page)
 	if err != nil {
 		return nil, err
 	}
 
+	users := make([]*domain.UserResponse, 0, len(result.Users))
+	for _, user := range result.Users {
+		users = append(users, newUserResponse(user))
+	}
+
+	return &domain.UserListResponse{
+		Users:      users,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// Logout 로그아웃 구현 (현재 토큰의 세션도 폐기 처리)
+func (uc *userUseCase) Logout(ctx context.Context, token string) error {
+	validation, validateErr := uc.authClient.ValidateToken(ctx, token)
+
+	if err := uc.authClient.RevokeToken(ctx, token); err != nil {
+		switch {
+		case errors.Is(err, client.ErrUnauthorized):
+			return domain.ErrInvalidToken
+		case client.IsUnavailable(err):
+			return authServiceError(err)
+		}
+		return fmt.Errorf("%w: %w", domain.ErrLogoutFailed, err)
+	}
+
+	if validateErr == nil && validation.SessionID != "" {
+		if err := uc.sessionRepo.Revoke(ctx, validation.UserID, validation.SessionID, time.Now()); err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+			uc.logger.WarnContext(ctx, "세션 폐기 기록 실패", "user_id", validation.UserID, "error", err)
+		}
+	}
+	return nil
+}
+
+// ForgotPassword 비밀번호 재설정 메일 요청 구현 (이메일 존재 여부는 노출하지 않음)
+func (uc *userUseCase) ForgotPassword(ctx context.Context, email string) error {
+	user, err := uc.findByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// 짧은 시간 내 반복 요청은 조용히 무시
+	recent, err := uc.passwordResetTokenRepo.CountCreatedSince(ctx, user.ID.Hex(), time.Now().Add(-uc.passwordResetCfg.RequestCooldown))
+	if err != nil {
+		return err
+	}
+	if recent > 0 {
+		return nil
+	}
+
+	rawToken, tokenHash, err := security.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	resetToken := &domain.UserToken{
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(uc.passwordResetCfg.TokenTTL),
+	}
+	if err := uc.passwordResetTokenRepo.Create(ctx, resetToken); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s?token=%s", uc.passwordResetCfg.URL, url.QueryEscape(rawToken))
+
+	if err := uc.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "비밀번호 재설정 안내",
+		Body: fmt.Sprintf(
+			"%s님, 안녕하세요.\n\n아래 링크에서 비밀번호를 재설정할 수 있습니다.\n%s\n\n이 링크는 %s 동안 유효합니다. 요청하지 않았다면 이 메일을 무시해주세요.",
+			user.Name,
+			link,
+			uc.passwordResetCfg.TokenTTL,
+		),
+	}); err != nil {
+		uc.logger.WarnContext(ctx, "비밀번호 재설정 메일 발송 실패", "user_id", user.ID.Hex(), "error", err)
+	}
+
+	return nil
+}
+
+// ResetPassword 비밀번호 재설정 구현
+func (uc *userUseCase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	resetToken, err := uc.passwordResetTokenRepo.Consume(ctx, security.HashToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidToken) {
+			return domain.ErrInvalidResetToken
+		}
+		return err
+	}
+	userID := resetToken.UserID.Hex()
+
+	passwordHash, err := uc.passwordHasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, userID, passwordHash); err != nil {
+		// 토큰 발급 후 삭제된 계정은 토큰이 무효가 된 것으로 취급
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	// 남은 재설정 토큰 정리
+	if err := uc.passwordResetTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		uc.logger.WarnContext(ctx, "재설정 토큰 정리 실패", "user_id", userID, "error", err)
+	}
+
+	// 기존에 발급된 모든 토큰 폐기
+	if err := uc.authClient.RevokeAllUserTokens(ctx, userID); err != nil {
+		uc.logger.ErrorContext(ctx, "비밀번호 재설정 후 토큰 일괄 폐기 실패", "user_id", userID, "error", err)
+	} else if err := uc.sessionRepo.RevokeAllByUserID(ctx, userID, time.Now()); err != nil {
+		uc.logger.WarnContext(ctx, "세션 일괄 폐기 기록 실패", "user_id", userID, "error", err)
+	}
+
+	return nil
+}
+
+// DeleteAccount 계정 소프트 삭제 구현
+func (uc *userUseCase) DeleteAccount(ctx context.Context, userID string) error {
+	now := time.Now()
+	if err := uc.userRepo.SoftDelete(ctx, userID, now); err != nil {
+		return err
+	}
+
+	// 삭제 전에 발급된 인증·재설정 링크로 계정이 변경되지 않도록 정리
+	if err := uc.verificationTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		uc.logger.WarnContext(ctx, "인증 토큰 정리 실패", "user_id", userID, "error", err)
+	}
+	if err := uc.passwordResetTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		uc.logger.WarnContext(ctx, "재설정 토큰 정리 실패", "user_id", userID, "error", err)
+	}
+
+	// 삭제 즉시 모든 기기에서 로그아웃 (실패해도 영구 삭제 시 다시 폐기)
+	if err := uc.authClient.RevokeAllUserTokens(ctx, userID); err != nil {
+		uc.logger.ErrorContext(ctx, "계정 삭제 후 토큰 일괄 폐기 실패", "user_id", userID, "error", err)
+	} else if err := uc.sessionRepo.RevokeAllByUserID(ctx, userID, now); err != nil {
+		uc.logger.WarnContext(ctx, "세션 일괄 폐기 기록 실패", "user_id", userID, "error", err)
+	}
+
+	return nil
+}
+
+// RestoreAccount 삭제된 계정 복구 구현
+func (uc *userUseCase) RestoreAccount(ctx context.Context, userID string) error {
+	return uc.userRepo.Restore(ctx, userID, time.Now().Add(-uc.deletionCfg.GracePeriod))
+}
+
+// PurgeDeletedAccounts 유예 기간이 지난 계정 영구 삭제 구현
+func (uc *userUseCase) PurgeDeletedAccounts(ctx context.Context) (int, error) {
+	users, err := uc.userRepo.FindDeletedBefore(ctx, time.Now().Add(-uc.deletionCfg.GracePeriod), uc.deletionCfg.PurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, user := range users {
+		userID := user.ID.Hex()
+
+		// 토큰 폐기에 실패하면 다음 주기에 재시도
+		if err := uc.authClient.RevokeAllUserTokens(ctx, userID); err != nil {
+			uc.logger.WarnContext(ctx, "계정 영구 삭제 중 토큰 폐기 실패", "user_id", userID, "error", err)
+			continue
+		}
+
+		if err := uc.verificationTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+			return purged, err
+		}
+		if err := uc.passwordResetTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+			return purged, err
+		}
+		if err := uc.loginEventRepo.DeleteByUserID(ctx, userID); err != nil {
+			return purged, err
+		}
+		if err := uc.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+			return purged, err
+		}
+		if err := uc.userRepo.Delete(ctx, userID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// findByEmail 입력 이메일을 정규화하여 사용자 조회 (형식 오류는 미존재로 취급)
+func (uc *userUseCase) findByEmail(ctx context.Context, email string) (*domain.User, error) {
+	normalizedEmail, err := uc.emailNormalizer.Canonical(email)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return uc.userRepo.FindByEmail(ctx, normalizedEmail)
+}
+
+// sendVerificationEmail 인증 토큰 발급 후 인증 메일 발송
+func (uc *userUseCase) sendVerificationEmail(ctx context.Context, user *domain.User) error {
+	rawToken, tokenHash, err := security.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	token := &domain.UserToken{
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(uc.verificationCfg.TokenTTL),
+	}
+	if err := uc.verificationTokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf(
+		"%s/api/users/%s/verify?token=%s",
+		uc.verificationCfg.BaseURL,
+		user.ID.Hex(),
+		url.QueryEscape(rawToken),
+	)
+
+	return uc.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "이메일 주소를 인증해주세요",
+		Body: fmt.Sprintf(
+			"%s님, 안녕하세요.\n\n아래 링크를 눌러 이메일 인증을 완료해주세요.\n%s\n\n이 링크는 %s 동안 유효합니다.",
+			user.Name,
+			link,
+			uc.verificationCfg.TokenTTL,
+		),
+	})
+}
+
+// newUserResponse 도메인 모델을 응답 DTO로 변환 (비밀번호 해시 등 민감 정보 제외)
+func newUserResponse(user *domain.User) *domain.UserResponse {
 	return &domain.UserResponse{
 		ID:         user.ID.Hex(),
 		Email:      user.Email,
@@ -169,10 +676,13 @@ func (uc *userUseCase) FindByEmail(ctx context.Context, email string) (*domain.U
 		IsVerified: user.IsVerified,
 		Profile:    user.Profile,
 		CreatedAt:  user.CreatedAt,
-	}, nil
+	}
 }
 
-// Logout 로그아웃 구현
-func (uc *userUseCase) Logout(ctx context.Context, token string) error {
-	return uc.authClient.RevokeToken(ctx, token)
+// authServiceError Auth 서비스 장애(연결 실패, 시간 초과, 회로 차단)를 503 응답용 도메인 에러로 변환
+func authServiceError(err error) error {
+	if client.IsUnavailable(err) {
+		return fmt.Errorf("%w: %w", domain.ErrAuthUnavailable, err)
+	}
+	return err
 }
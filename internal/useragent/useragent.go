@@ -0,0 +1,58 @@
+// quser/internal/useragent/useragent.go
+package useragent
+
+import "strings"
+
+// 순서가 중요하다: 다른 브라우저의 User-Agent에도 Chrome, Safari 등이 함께 포함된다
+var browsers = []struct{ token, name string }{
+	{"edg/", "Edge"},
+	{"opr/", "Opera"},
+	{"samsungbrowser/", "Samsung Internet"},
+	{"firefox/", "Firefox"},
+	{"fxios/", "Firefox"},
+	{"crios/", "Chrome"},
+	{"chrome/", "Chrome"},
+	{"safari/", "Safari"},
+}
+
+var platforms = []struct{ token, name string }{
+	{"iphone", "iOS"},
+	{"ipad", "iPadOS"},
+	{"android", "Android"},
+	{"windows", "Windows"},
+	{"mac os x", "macOS"},
+	{"cros", "ChromeOS"},
+	{"linux", "Linux"},
+}
+
+// Describe User-Agent를 "브라우저 on 플랫폼" 형태의 기기 설명으로 변환 (알 수 없으면 Unknown)
+func Describe(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	browser := ""
+	for _, b := range browsers {
+		if strings.Contains(ua, b.token) {
+			browser = b.name
+			break
+		}
+	}
+
+	platform := ""
+	for _, p := range platforms {
+		if strings.Contains(ua, p.token) {
+			platform = p.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,72 @@
+// quser/internal/validation/validator.go
+package validation
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/signalable/quser/internal/domain"
+)
+
+// 허용하는 아바타 URL 스킴
+var allowedAvatarSchemes = map[string]bool{
+	"https": true,
+}
+
+// Validator 요청 DTO 검증기 (`validate` 구조체 태그 기반)
+type Validator struct {
+	validate *validator.Validate
+}
+
+// NewValidator 검증기 생성자
+func NewValidator() *Validator {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// 에러의 필드명을 JSON 이름으로 표시
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	v.RegisterValidation("avatar_url", validateAvatarURL)
+
+	return &Validator{validate: v}
+}
+
+// Validate 구조체 검증 (실패 시 *domain.ValidationError 반환)
+func (v *Validator) Validate(s interface{}) error {
+	err := v.validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	fields := make([]domain.FieldError, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		fields = append(fields, domain.FieldError{
+			Field: fe.Field(),
+			Rule:  fe.Tag(),
+			Param: fe.Param(),
+		})
+	}
+	return &domain.ValidationError{Fields: fields}
+}
+
+// validateAvatarURL 허용된 스킴의 절대 URL인지 확인
+func validateAvatarURL(fl validator.FieldLevel) bool {
+	u, err := url.Parse(fl.Field().String())
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return allowedAvatarSchemes[strings.ToLower(u.Scheme)]
+}
@@ -0,0 +1,85 @@
+// quser/internal/worker/account_purger.go
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/signalable/quser/internal/usecase"
+)
+
+// AccountPurger 유예 기간이 지난 삭제 계정을 주기적으로 영구 삭제하는 백그라운드 작업
+type AccountPurger struct {
+	userUseCase usecase.UserUseCase
+	interval    time.Duration
+	logger      *slog.Logger
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+// NewAccountPurger 계정 영구 삭제 작업 생성자
+func NewAccountPurger(userUseCase usecase.UserUseCase, interval time.Duration, logger *slog.Logger) *AccountPurger {
+	return &AccountPurger{
+		userUseCase: userUseCase,
+		interval:    interval,
+		logger:      logger.With("component", "account_purger"),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start 백그라운드 작업 시작
+func (p *AccountPurger) Start() {
+	go p.run()
+}
+
+// Stop 백그라운드 작업 중지 (진행 중인 작업이 끝나거나 ctx가 만료될 때까지 대기)
+func (p *AccountPurger) Stop(ctx context.Context) error {
+	close(p.stop)
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *AccountPurger) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.purge()
+		}
+	}
+}
+
+func (p *AccountPurger) purge() {
+	ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+	defer cancel()
+
+	// 중지 요청 시 진행 중인 작업도 취소
+	go func() {
+		select {
+		case <-p.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	purged, err := p.userUseCase.PurgeDeletedAccounts(ctx)
+	if err != nil {
+		p.logger.ErrorContext(ctx, "계정 영구 삭제 실패", "error", err)
+	}
+	if purged > 0 {
+		p.logger.InfoContext(ctx, "계정 영구 삭제 완료", "count", purged)
+	}
+}